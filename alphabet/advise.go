@@ -0,0 +1,161 @@
+package alphabet
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// ambiguous are groups of characters and character sequences, which are easily confused when read, by the ISO 15924
+// code of the script of the alphabets they apply to.
+var ambiguous = map[string][][]string{
+	"Latn": {
+		{"0", "O", "o"},
+		{"1", "l", "I", "|"},
+		{"2", "Z"},
+		{"5", "S", "s"},
+		{"8", "B"},
+		{"rn", "m"},
+		{"vv", "w"},
+		{"cl", "d"},
+	},
+	"Cyrl": {
+		{"0", "О", "о"},
+		{"1", "|"},
+		{"3", "З", "з"},
+		{"6", "б"},
+	},
+}
+
+// Advisory reports a character, which is easily confused with others when read.
+type Advisory struct {
+	// Pos is the position of Text in the spelled text, counting grapheme clusters from 1.
+	Pos int
+	// Text is the ambiguous character or character sequence, like "0" or "rn".
+	Text string
+	// Confusable are the characters Text is easily confused with, like "O" or "m".
+	Confusable []string
+}
+
+// String describes a, like "'0' can be confused with 'O'".
+func (a Advisory) String() string {
+	s := fmt.Sprintf("'%s' can be confused with", a.Text)
+	for i, c := range a.Confusable {
+		switch {
+		case i == 0:
+			s += " "
+		case i == len(a.Confusable)-1:
+			s += " or "
+		default:
+			s += ", "
+		}
+		s += "'" + c + "'"
+	}
+	return s
+}
+
+// Advise finds characters in text, which are easily confused with others when read, like "0" and "O" or "rn" and "m".
+//
+// The characters depend on the script of sa: Latin alphabets report the Latin "O" next to "0", Cyrillic alphabets the
+// Cyrillic "О". Alphabets of other scripts report nothing.
+func (sa SpellingAlphabet) Advise(text string) []Advisory {
+	var advisories []Advisory
+	groups := sa.ambiguous()
+	clusters := Graphemes(text)
+	for i := 0; i < len(clusters); i++ {
+		if i+1 < len(clusters) {
+			if group := ambiguousGroup(groups, clusters[i]+clusters[i+1]); group != nil {
+				advisories = append(advisories, Advisory{i + 1, clusters[i] + clusters[i+1], others(group, clusters[i]+clusters[i+1])})
+				i++
+				continue
+			}
+		}
+		if group := ambiguousGroup(groups, clusters[i]); group != nil {
+			advisories = append(advisories, Advisory{i + 1, clusters[i], others(group, clusters[i])})
+		}
+	}
+	return advisories
+}
+
+// emphasisLabels name the kinds of characters for emphasis in a language.
+type emphasisLabels struct {
+	digit, letter, capital, small, symbol string
+}
+
+var emphasis = map[string]emphasisLabels{
+	"en": {"digit", "letter", "capital letter", "small letter", "symbol"},
+	"de": {"Ziffer", "Buchstabe", "Großbuchstabe", "Kleinbuchstabe", "Zeichen"},
+}
+
+// Emphasize returns the Word of a Token of an ambiguous character together with its kind, like "Zero (digit)" and
+// "Oscar (letter)". Letters name their case, if a letter of the other case is in the same group, like "India (capital
+// letter)" and "Lima (small letter)". Other tokens return their Word unchanged.
+//
+// The kinds are named in the language of sa, if it is English or German, and in English otherwise.
+func (sa SpellingAlphabet) Emphasize(t Token) string {
+	char, word := t.Text, t.Word
+	group := ambiguousGroup(sa.ambiguous(), char)
+	if group == nil || len([]rune(char)) != 1 {
+		return word
+	}
+
+	base, _ := sa.lang.Base()
+	labels, ok := emphasis[base.String()]
+	if !ok {
+		labels = emphasis["en"]
+	}
+
+	r := []rune(char)[0]
+	label := labels.symbol
+	switch {
+	case unicode.IsDigit(r):
+		label = labels.digit
+	case unicode.IsLetter(r) && !hasBothCases(group):
+		label = labels.letter
+	case unicode.IsUpper(r):
+		label = labels.capital
+	case unicode.IsLower(r):
+		label = labels.small
+	}
+	return fmt.Sprintf("%s (%s)", word, label)
+}
+
+// ambiguous returns the groups of easily confused characters of the script of sa.
+func (sa SpellingAlphabet) ambiguous() [][]string {
+	script, _ := sa.lang.Script()
+	return ambiguous[script.String()]
+}
+
+// ambiguousGroup returns the group of groups, which contains s, or nil.
+func ambiguousGroup(groups [][]string, s string) []string {
+	for _, group := range groups {
+		for _, member := range group {
+			if member == s {
+				return group
+			}
+		}
+	}
+	return nil
+}
+
+// others returns all members of group except s.
+func others(group []string, s string) []string {
+	var o []string
+	for _, member := range group {
+		if member != s {
+			o = append(o, member)
+		}
+	}
+	return o
+}
+
+// hasBothCases reports whether group has single upper and lower case letters.
+func hasBothCases(group []string) bool {
+	var upper, lower bool
+	for _, member := range group {
+		if r := []rune(member); len(r) == 1 {
+			upper = upper || unicode.IsUpper(r[0])
+			lower = lower || unicode.IsLower(r[0])
+		}
+	}
+	return upper && lower
+}
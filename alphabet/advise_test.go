@@ -0,0 +1,77 @@
+package alphabet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAdvise(t *testing.T) {
+	got := English.Advise("O0l1-corn5")
+	tests := []struct {
+		pos  int
+		text string
+	}{
+		{1, "'O' can be confused with '0' or 'o'"},
+		{2, "'0' can be confused with 'O' or 'o'"},
+		{3, "'l' can be confused with '1', 'I' or '|'"},
+		{4, "'1' can be confused with 'l', 'I' or '|'"},
+		{7, "'o' can be confused with '0' or 'O'"},
+		{8, "'rn' can be confused with 'm'"},
+		{10, "'5' can be confused with 'S' or 's'"},
+	}
+	if len(got) != len(tests) {
+		t.Fatalf("Advise() = %v, want %d advisories", got, len(tests))
+	}
+	for i, tt := range tests {
+		if got[i].Pos != tt.pos || got[i].String() != tt.text {
+			t.Errorf("Advise()[%d] = %d %v, want %d %v", i, got[i].Pos, got[i], tt.pos, tt.text)
+		}
+	}
+}
+
+func TestAdvise_Script(t *testing.T) {
+	got := Russian.Advise("З0O")
+	want := []string{"'З' can be confused with '3' or 'з'", "'0' can be confused with 'О' or 'о'"}
+	if len(got) != len(want) {
+		t.Fatalf("Advise() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("Advise()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestEmphasize(t *testing.T) {
+	tests := []struct {
+		alphabet SpellingAlphabet
+		text     string
+		want     string
+	}{
+		{English, "0", "Zero (digit)"},
+		{English, "O", "Oscar (capital letter)"},
+		{English, "o", "Oscar (small letter)"},
+		{English, "2", "Two (digit)"},
+		{English, "Z", "Zulu (letter)"},
+		{English, "I", "India (capital letter)"},
+		{English, "l", "Lima (small letter)"},
+		{English, "|", "Vertical Bar (symbol)"},
+		{English, "a", "Alfa"},
+		{German, "0", "Null (Ziffer)"},
+		{German, "S", "Samuel (Großbuchstabe)"},
+		{French, "O", "Oscar (capital letter)"},
+		{Russian, "О", "Ольга (capital letter)"},
+		{MaritimeEnglish, "1.5", "Unaone (digit) Decimal Pantafive (digit)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alphabet.LangTag()+" "+tt.text, func(t *testing.T) {
+			var words []string
+			for _, token := range tt.alphabet.Tokenize(tt.text) {
+				words = append(words, tt.alphabet.Emphasize(token))
+			}
+			if got := strings.Join(words, " "); got != tt.want {
+				t.Errorf("Emphasize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"golang.org/x/text/language"
 	"golang.org/x/text/language/display"
+	"golang.org/x/text/unicode/norm"
+	"sort"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // SpellingAlphabet represents a word-spelling alphabet.
@@ -21,6 +24,12 @@ type SpellingAlphabet struct {
 	m map[string]string
 	// Language specific case mappings. Can be nil.
 	c *unicode.SpecialCase
+	// Phonetic form of a "." between digits, like "Decimal". If it is empty, m spells all "." alike.
+	decimal string
+	// Metadata on the definition and use of this SpellingAlphabet.
+	meta Metadata
+	// Map common variants of phonetic forms to their lower case keys, like "alpha" to "a". Can be nil.
+	aliases map[string]string
 }
 
 // Names of organisations or standards, defining or using this SpellingAlphabet.
@@ -28,6 +37,12 @@ func (sa SpellingAlphabet) Names() []string {
 	return sa.names
 }
 
+// Metadata returns the standard, source and status of the SpellingAlphabet, so authoritative alphabets can be told
+// apart from informal ones.
+func (sa SpellingAlphabet) Metadata() Metadata {
+	return sa.meta
+}
+
 // LangTag returns a BCP 47 tag, describing where SpellingAlphabet is used.
 func (sa SpellingAlphabet) LangTag() string {
 	return sa.lang.String()
@@ -43,26 +58,88 @@ func (sa SpellingAlphabet) LangSelfName() string {
 	return display.Self.Name(sa.lang)
 }
 
+// Keys returns all keys of SpellingAlphabet in lower case, sorted by byte order.
+func (sa SpellingAlphabet) Keys() []string {
+	keys := make([]string, 0, len(sa.m))
+	for key := range sa.m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // Spell generates the text to speak for spelling text.
 func (sa SpellingAlphabet) Spell(text string) string {
+	tokens := sa.Tokenize(text)
+	words := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		words = append(words, t.Word)
+	}
+	return strings.Join(words, " ")
+}
+
+// Token is a part of a spelled text together with its phonetic form.
+type Token struct {
+	// Text is the spelled part of the text, like "a" or "Sch".
+	Text string
+	// Word is the phonetic form of Text. Characters without a phonetic form are quoted, like "'€'".
+	// Word is empty for text, which is not said, like spaces between literal words.
+	Word string
+	// Kind of Text.
+	Kind Kind
+}
+
+// Tokenize splits text into the parts, which Spell spells with one phonetic form each.
+//
+// Keys of several characters, like "sch", take precedence over shorter keys.
+// Tokens never split a grapheme cluster: a character with combining marks, like "e\u0301", is spelled as a whole.
+func (sa SpellingAlphabet) Tokenize(text string) []Token {
 	maxKeyLen := sa.maxMKeyLen()
 
-	var sb strings.Builder
+	boundaries := map[int]bool{len(text): true}
+	var offset int
+	for _, cluster := range Graphemes(text) {
+		boundaries[offset] = true
+		offset += len(cluster)
+	}
+
+	var tokens []Token
 	for i := 0; i < len(text); {
 
 		matchGroup := text[i:]
-		if len(matchGroup) > maxKeyLen {
-			matchGroup = matchGroup[:maxKeyLen]
+		matchLen := maxKeyLen
+		if _, size := utf8.DecodeRuneInString(matchGroup); matchLen < size {
+			matchLen = size
+		}
+		if len(matchGroup) > matchLen {
+			matchGroup = matchGroup[:matchLen]
 		}
 
 		key, value := sa.spellFirstMatch(matchGroup)
-		i += len(key)
-		sb.WriteString(value)
-		if i != len(text) {
-			sb.WriteRune(' ')
+		if !boundaries[i+len(key)] {
+			key = text[i : i+graphemeLen(text[i:])]
+			value = sa.spellCluster(key)
+		}
+		if key == "." && sa.decimal != "" && isDigitAt(text, i-1) && isDigitAt(text, i+1) {
+			value = sa.decimal
 		}
+		i += len(key)
+		tokens = append(tokens, Token{key, value, KindOf(key)})
+	}
+	return tokens
+}
+
+func isDigitAt(text string, i int) bool {
+	return i >= 0 && i < len(text) && '0' <= text[i] && text[i] <= '9'
+}
+
+// spellCluster spells a grapheme cluster of several characters by the key of its composed form, like "ä" for "a\u0308".
+// Clusters without a key are quoted.
+func (sa SpellingAlphabet) spellCluster(cluster string) string {
+	if value, ok := sa.m[sa.lower(norm.NFC.String(cluster))]; ok {
+		return value
 	}
-	return sb.String()
+	return fmt.Sprintf("'%s'", cluster)
 }
 
 func (sa SpellingAlphabet) maxMKeyLen() int {
@@ -76,16 +153,10 @@ func (sa SpellingAlphabet) maxMKeyLen() int {
 }
 
 func (sa SpellingAlphabet) spellFirstMatch(key string) (string, string) {
-	var lowerKey string
-	if sa.c == nil {
-		lowerKey = strings.ToLower(key)
-	} else {
-		lowerKey = strings.ToLowerSpecial(*sa.c, key)
-	}
-	if value, ok := sa.m[lowerKey]; ok {
+	if value, ok := sa.m[sa.lower(key)]; ok {
 		return key, value
 	} else {
-		if len(key) == 1 {
+		if _, size := utf8.DecodeRuneInString(key); len(key) <= size {
 			return key, fmt.Sprintf("'%s'", key)
 		} else {
 			return sa.spellFirstMatch(key[:len(key)-1])
@@ -95,33 +166,142 @@ func (sa SpellingAlphabet) spellFirstMatch(key string) (string, string) {
 
 // Lookup returns the best matching SpellingAlphabet of All together with a confidence score.
 //
-// Lookup interprets lang as a BCP 47 language tag and finds the best match for the SpellingAlphabets lang.
+// Lookup interprets lang as one of the Names of a SpellingAlphabet, like "NATO", or as a BCP 47 language tag and finds
+// the best match for the SpellingAlphabets lang.
 // golang.org/x/text/language is used for finding the best match.
+// A language tag selects the default SpellingAlphabet of its language. Variants are selected with a private use tag,
+// like "de-DE-x-din2022", or the equivalent Unicode extension "de-DE-u-va-din2022". Unknown variants fall back to the
+// default SpellingAlphabet.
 // If there is no match, 'en' is used as the default SpellingAlphabet.
 func Lookup(lang string) (SpellingAlphabet, Exactness) {
-	tags := make([]language.Tag, 0, len(All))
 	for _, alphabet := range All {
-		tags = append(tags, alphabet.lang)
+		for _, name := range alphabet.names {
+			if strings.EqualFold(name, lang) {
+				return alphabet, Exact
+			}
+		}
 	}
 
-	matcher := language.NewMatcher(tags)
-
 	tag, err := language.Parse(lang)
 	if err != nil {
 		return All[0], Default
 	}
-	_, i, c := matcher.Match(tag)
 
-	return All[i], FromLangConfidence(c)
+	variant := variantOf(tag)
+	if alphabet, exactness := match(tag, variant); exactness != Default {
+		return alphabet, exactness
+	}
+	alphabet, exactness := match(tag, "")
+	if variant != "" && exactness == Exact {
+		exactness = Guess
+	}
+	return alphabet, exactness
+}
+
+// match finds the best match for tag among the SpellingAlphabets of All, which are the variant.
+func match(tag language.Tag, variant string) (SpellingAlphabet, Exactness) {
+	var alphabets []SpellingAlphabet
+	var tags []language.Tag
+	for _, alphabet := range All {
+		if alphabet.Variant() == variant {
+			alphabets = append(alphabets, alphabet)
+			tags = append(tags, withoutVariant(alphabet.lang))
+		}
+	}
+	if len(alphabets) == 0 {
+		return All[0], Default
+	}
+
+	matcher := language.NewMatcher(tags)
+	_, i, c := matcher.Match(withoutVariant(tag))
+
+	return alphabets[i], FromLangConfidence(c)
+}
+
+// Variant returns the variant of sa, like "maritime" of "en-x-maritime".
+// It is empty for the default SpellingAlphabet of a language tag.
+func (sa SpellingAlphabet) Variant() string {
+	return variantOf(sa.lang)
+}
+
+// Variants returns all SpellingAlphabets of All, which are variants of the default SpellingAlphabet sa, sorted by their
+// language tag.
+func (sa SpellingAlphabet) Variants() []SpellingAlphabet {
+	if sa.Variant() != "" {
+		return nil
+	}
+	var variants []SpellingAlphabet
+	for _, alphabet := range All {
+		if alphabet.Variant() != "" && withoutVariant(alphabet.lang) == sa.lang {
+			variants = append(variants, alphabet)
+		}
+	}
+	sort.Slice(variants, func(i, j int) bool {
+		return variants[i].LangTag() < variants[j].LangTag()
+	})
+	return variants
+}
+
+// variantOf returns the private use subtags of tag, like "din2022" of "de-DE-x-din2022", or the value of its "va" key,
+// like "din2022" of "de-DE-u-va-din2022".
+func variantOf(tag language.Tag) string {
+	if ext, ok := tag.Extension('x'); ok {
+		return strings.Join(ext.Tokens()[1:], "-")
+	}
+	return tag.TypeForKey("va")
+}
+
+// withoutVariant returns tag without extensions, like "de-DE" of "de-DE-x-din2022".
+func withoutVariant(tag language.Tag) language.Tag {
+	s := tag.String()
+	for _, ext := range []string{"-u-", "-x-"} {
+		if i := strings.Index(s, ext); i >= 0 {
+			s = s[:i]
+		}
+	}
+	return language.Make(s)
+}
+
+// variant returns a copy of m, where the phonetic forms of the keys in changes are replaced.
+// Keys changed to an empty phonetic form are removed.
+func variant(m map[string]string, changes map[string]string) map[string]string {
+	v := make(map[string]string, len(m))
+	for key, value := range m {
+		v[key] = value
+	}
+	for key, value := range changes {
+		if value == "" {
+			delete(v, key)
+			continue
+		}
+		v[key] = value
+	}
+	return v
+}
+
+// icaoAliases are common spellings of the ICAO phonetic forms, like "Alpha" for "Alfa", and the spoken digits of
+// radiotelephony, like "Niner" for "Nine".
+var icaoAliases = map[string]string{
+	"alpha":  "a",
+	"juliet": "j",
+	"whisky": "w",
+	"tree":   "3",
+	"fife":   "5",
+	"niner":  "9",
 }
 
 // All SpellingAlphabet.
 var All = []SpellingAlphabet{
 	English,
 	BritishEnglish,
+	MaritimeEnglish,
+	APCOEnglish,
+	AbleBakerEnglish,
+	RAFEnglish,
 	French,
 	Dutch,
 	German,
+	CityGerman,
 	AustrianGerman,
 	SwissHighGerman,
 	Italian,
@@ -132,6 +312,7 @@ var All = []SpellingAlphabet{
 	Finnish,
 	Danish,
 	Czech,
+	Polish,
 	EuropeanPortuguese,
 	BrazilianPortuguese,
 	Romanian,
@@ -142,8 +323,17 @@ var All = []SpellingAlphabet{
 
 var (
 	English = SpellingAlphabet{
-		lang:  language.English,
-		names: []string{"ICAO", "NATO"},
+		lang:    language.English,
+		names:   []string{"ICAO", "NATO"},
+		aliases: icaoAliases,
+		meta: Metadata{
+			Standard:  "ICAO Annex 10",
+			Version:   "1956",
+			Source:    "ICAO Annex 10 to the Convention on International Civil Aviation, Volume II, Chapter 5",
+			Status:    Current,
+			Connector: "as in",
+			Notes:     "Also adopted by NATO and the ITU. Digits and symbols are spelled with their English names.",
+		},
 		m: map[string]string{
 			"a":  "Alfa",
 			"b":  "Bravo",
@@ -218,8 +408,152 @@ var (
 			"^":  "Caret",
 		},
 	}
+	MaritimeEnglish = SpellingAlphabet{
+		lang:    language.MustParse("en-x-maritime"),
+		names:   []string{"ITU-maritime", "ITU Radio Regulations Appendix 14"},
+		aliases: icaoAliases,
+		meta: Metadata{
+			Standard:  "ITU Radio Regulations",
+			Source:    "ITU Radio Regulations, Appendix 14",
+			Status:    Current,
+			Connector: "as in",
+			Notes:     "Maritime radio spells the letters like ICAO and the digits with compound words, like Unaone.",
+		},
+		m: variant(English.m, map[string]string{
+			"0": "Nadazero",
+			"1": "Unaone",
+			"2": "Bissotwo",
+			"3": "Terrathree",
+			"4": "Kartefour",
+			"5": "Pantafive",
+			"6": "Soxisix",
+			"7": "Setteseven",
+			"8": "Oktoeight",
+			"9": "Novenine",
+			".": "Stop",
+		}),
+		decimal: "Decimal",
+	}
+	APCOEnglish = SpellingAlphabet{
+		lang:  language.MustParse("en-x-apco"),
+		names: []string{"APCO", "LAPD"},
+		meta: Metadata{
+			Standard:  "APCO",
+			Source:    "Association of Public-Safety Communications Officials",
+			Status:    Superseded,
+			Connector: "as in",
+			Notes:     "US law enforcement radio since the 1940s. APCO now recommends the ICAO alphabet, but many police departments, like the LAPD, still use it.",
+		},
+		m: variant(English.m, map[string]string{
+			"a": "Adam",
+			"b": "Boy",
+			"c": "Charles",
+			"d": "David",
+			"e": "Edward",
+			"f": "Frank",
+			"g": "George",
+			"h": "Henry",
+			"i": "Ida",
+			"j": "John",
+			"k": "King",
+			"l": "Lincoln",
+			"m": "Mary",
+			"n": "Nora",
+			"o": "Ocean",
+			"p": "Paul",
+			"q": "Queen",
+			"r": "Robert",
+			"s": "Sam",
+			"t": "Tom",
+			"u": "Union",
+			"v": "Victor",
+			"w": "William",
+			"x": "X-ray",
+			"y": "Young",
+			"z": "Zebra",
+		}),
+	}
+	AbleBakerEnglish = SpellingAlphabet{
+		lang:  language.MustParse("en-x-able"),
+		names: []string{"Able Baker", "Joint Army/Navy"},
+		meta: Metadata{
+			Standard:  "Joint Army/Navy Phonetic Alphabet",
+			Version:   "1941",
+			Status:    Superseded,
+			Connector: "as in",
+			Notes:     "Used by the US and British armed forces during World War II and replaced by the ICAO alphabet in 1956.",
+		},
+		m: variant(English.m, map[string]string{
+			"a": "Able",
+			"b": "Baker",
+			"c": "Charlie",
+			"d": "Dog",
+			"e": "Easy",
+			"f": "Fox",
+			"g": "George",
+			"h": "How",
+			"i": "Item",
+			"j": "Jig",
+			"k": "King",
+			"l": "Love",
+			"m": "Mike",
+			"n": "Nan",
+			"o": "Oboe",
+			"p": "Peter",
+			"q": "Queen",
+			"r": "Roger",
+			"s": "Sugar",
+			"t": "Tare",
+			"u": "Uncle",
+			"v": "Victor",
+			"w": "William",
+			"x": "X-ray",
+			"y": "Yoke",
+			"z": "Zebra",
+		}),
+	}
+	RAFEnglish = SpellingAlphabet{
+		lang:  language.MustParse("en-GB-x-raf1942"),
+		names: []string{"RAF 1942"},
+		meta: Metadata{
+			Standard:  "RAF Phonetic Alphabet",
+			Version:   "1942",
+			Status:    Superseded,
+			Connector: "as in",
+			Notes:     "Used by the Royal Air Force from 1942 to 1943 and replaced by the Joint Army/Navy alphabet.",
+		},
+		m: variant(English.m, map[string]string{
+			"a": "Ace",
+			"b": "Beer",
+			"c": "Charlie",
+			"d": "Don",
+			"e": "Edward",
+			"f": "Freddie",
+			"g": "George",
+			"h": "Harry",
+			"i": "Ink",
+			"j": "Johnnie",
+			"k": "King",
+			"l": "London",
+			"m": "Monkey",
+			"n": "Nuts",
+			"o": "Orange",
+			"p": "Pip",
+			"q": "Queen",
+			"r": "Robert",
+			"s": "Sugar",
+			"t": "Toc",
+			"u": "Uncle",
+			"v": "Vic",
+			"w": "William",
+			"x": "X-ray",
+			"y": "Yorker",
+			"z": "Zebra",
+		}),
+	}
 	BritishEnglish = SpellingAlphabet{
 		lang: language.BritishEnglish,
+		meta: Metadata{Connector: "for"},
 		m: map[string]string{
 			"a": "Alfred",
 			"b": "Benjamin",
@@ -251,6 +585,7 @@ var (
 	}
 	French = SpellingAlphabet{
 		lang: language.French,
+		meta: Metadata{Connector: "comme"},
 		m: map[string]string{
 			"a": "Anatole",
 			"b": "Berthe",
@@ -282,6 +617,7 @@ var (
 	}
 	Dutch = SpellingAlphabet{
 		lang: language.Dutch,
+		meta: Metadata{Connector: "van"},
 		m: map[string]string{
 			"a": "Anna/Anton",
 			"b": "Bernard",
@@ -314,6 +650,14 @@ var (
 	German = SpellingAlphabet{
 		lang:  language.MustParse("de-DE"),
 		names: []string{"DIN 5009"},
+		meta: Metadata{
+			Standard:  "DIN 5009",
+			Version:   "1983",
+			Source:    "DIN 5009:1983-03",
+			Status:    Superseded,
+			Connector: "wie",
+			Notes:     "The first names are still widely used, although DIN 5009:2022 replaced them by names of cities.",
+		},
 		m: map[string]string{
 			"a":   "Anton",
 			"ä":   "Ärger",
@@ -394,9 +738,60 @@ var (
 			"^":   "Zirkumflex",
 		},
 	}
+	// CityGerman is the revision of DIN 5009 from 2022, which replaced the first names by names of cities. It is used
+	// by public authorities. German remains the default for de-DE, as long as the first names are more widely known.
+	CityGerman = SpellingAlphabet{
+		lang:  language.MustParse("de-DE-x-din2022"),
+		names: []string{"DIN 5009:2022"},
+		meta: Metadata{
+			Standard:  "DIN 5009",
+			Version:   "2022",
+			Source:    "DIN 5009:2022-06",
+			Status:    Current,
+			Connector: "wie",
+			Notes:     "Used by public authorities. Umlauts are spelled as Umlaut of their base letter.",
+		},
+		m: variant(German.m, map[string]string{
+			"a":   "Aachen",
+			"ä":   "Umlaut Aachen",
+			"b":   "Berlin",
+			"c":   "Chemnitz",
+			"ch":  "",
+			"d":   "Düsseldorf",
+			"e":   "Essen",
+			"f":   "Frankfurt",
+			"g":   "Goslar",
+			"h":   "Hamburg",
+			"i":   "Ingelheim",
+			"j":   "Jena",
+			"k":   "Köln",
+			"l":   "Leipzig",
+			"m":   "München",
+			"n":   "Nürnberg",
+			"o":   "Offenbach",
+			"ö":   "Umlaut Offenbach",
+			"p":   "Potsdam",
+			"q":   "Quickborn",
+			"r":   "Rostock",
+			"s":   "Salzwedel",
+			"sch": "",
+			"t":   "Tübingen",
+			"u":   "Unna",
+			"ü":   "Umlaut Unna",
+			"v":   "Völklingen",
+			"w":   "Wuppertal",
+			"x":   "Xanten",
+			"z":   "Zwickau",
+		}),
+	}
 	AustrianGerman = SpellingAlphabet{
 		lang:  language.MustParse("de-AT"),
 		names: []string{"ÖNORM A 1081"},
+		meta: Metadata{
+			Standard:  "ÖNORM A 1081",
+			Status:    Current,
+			Connector: "wie",
+		},
 		m: map[string]string{
 			"a":   "Anton",
 			"ä":   "Ärger",
@@ -479,6 +874,7 @@ var (
 	}
 	SwissHighGerman = SpellingAlphabet{
 		lang: language.MustParse("de-CH"),
+		meta: Metadata{Connector: "wie"},
 		m: map[string]string{
 			"a":  "Anna",
 			"ä":  "Äsch",
@@ -559,6 +955,7 @@ var (
 	}
 	Italian = SpellingAlphabet{
 		lang: language.Italian,
+		meta: Metadata{Connector: "come", Notes: "Most letters are spelled with names of Italian cities."},
 		m: map[string]string{
 			"a": "Ancona",
 			"b": "Bari",
@@ -590,6 +987,7 @@ var (
 	}
 	Spanish = SpellingAlphabet{
 		lang: language.Spanish,
+		meta: Metadata{Connector: "de"},
 		m: map[string]string{
 			"a":  "Antonio",
 			"b":  "Burgos",
@@ -624,6 +1022,7 @@ var (
 	}
 	Turkish = SpellingAlphabet{
 		lang: language.Turkish,
+		meta: Metadata{Notes: "Letters are spelled with names of Turkish provinces."},
 		m: map[string]string{
 			"a": "Adana",
 			"b": "Bolu",
@@ -654,7 +1053,7 @@ var (
 			"v": "Van",
 			"w": "duble V",
 			"y": "Yozgat",
-			"z": "Yozgat",
+			"z": "Zonguldak",
 		},
 		c: &unicode.TurkishCase,
 	}
@@ -841,8 +1240,91 @@ var (
 			"ž":  "Žofie",
 		},
 	}
+	Polish = SpellingAlphabet{
+		lang: language.Polish,
+		meta: Metadata{Connector: "jak", Notes: "The digraphs are spelled as units, like Dżem for dż."},
+		m: map[string]string{
+			"a":  "Adam",
+			"ą":  "a z ogonkiem",
+			"b":  "Barbara",
+			"c":  "Celina",
+			"ch": "Chleb",
+			"cz": "Czesław",
+			"ć":  "Ćma",
+			"d":  "Dorota",
+			"dz": "Dzwon",
+			"dź": "Dźwig",
+			"dż": "Dżem",
+			"e":  "Ewa",
+			"ę":  "e z ogonkiem",
+			"f":  "Franciszek",
+			"g":  "Grażyna",
+			"h":  "Henryk",
+			"i":  "Irena",
+			"j":  "Józef",
+			"k":  "Karol",
+			"l":  "Ludwik",
+			"ł":  "Łukasz",
+			"m":  "Marek",
+			"n":  "Natalia",
+			"ń":  "en z kreską",
+			"o":  "Olga",
+			"ó":  "Ósemka",
+			"p":  "Paweł",
+			"q":  "Quo vadis",
+			"r":  "Roman",
+			"rz": "Rzeka",
+			"s":  "Stefan",
+			"sz": "Szymon",
+			"ś":  "Śliwa",
+			"t":  "Tadeusz",
+			"u":  "Urszula",
+			"v":  "Violetta",
+			"w":  "Wacław",
+			"x":  "Xawery",
+			"y":  "Ypsylon",
+			"z":  "Zygmunt",
+			"ź":  "Źrebię",
+			"ż":  "Żaba",
+			"0":  "Zero",
+			"1":  "Jeden",
+			"2":  "Dwa",
+			"3":  "Trzy",
+			"4":  "Cztery",
+			"5":  "Pięć",
+			"6":  "Sześć",
+			"7":  "Siedem",
+			"8":  "Osiem",
+			"9":  "Dziewięć",
+			" ":  "Spacja",
+			".":  "Kropka",
+			",":  "Przecinek",
+			";":  "Średnik",
+			":":  "Dwukropek",
+			"?":  "Znak zapytania",
+			"!":  "Wykrzyknik",
+			"@":  "Małpa",
+			"&":  "Ampersand",
+			"\"": "Cudzysłów",
+			"'":  "Apostrof",
+			"-":  "Myślnik",
+			"/":  "Ukośnik",
+			"\\": "Ukośnik wsteczny",
+			"(":  "Nawias otwierający",
+			")":  "Nawias zamykający",
+			"*":  "Gwiazdka",
+			"+":  "Plus",
+			"=":  "Znak równości",
+			"#":  "Krzyżyk",
+			"%":  "Procent",
+			"_":  "Podkreślnik",
+			"$":  "Dolar",
+			"€":  "Euro",
+		},
+	}
 	EuropeanPortuguese = SpellingAlphabet{
 		lang: language.EuropeanPortuguese,
+		meta: Metadata{Connector: "de"},
 		m: map[string]string{
 			"a": "Aveiro",
 			"b": "Braga",
@@ -874,6 +1356,7 @@ var (
 	}
 	BrazilianPortuguese = SpellingAlphabet{
 		lang: language.BrazilianPortuguese,
+		meta: Metadata{Connector: "de"},
 		m: map[string]string{
 			"a": "Amor",
 			"b": "Bandeira",
@@ -58,6 +58,8 @@ func TestSpell_Words(t *testing.T) {
 
 func TestSpell_SpecialCharacter(t *testing.T) {
 	testSpell(t, alphabet, "?", "'?'")
+	testSpell(t, alphabet, "é", "'é'")
+	testSpell(t, alphabet, "a😀", "Anton '😀'")
 }
 
 func TestForLanguageCode(t *testing.T) {
@@ -76,6 +78,22 @@ func TestForLanguageCode(t *testing.T) {
 		{"zh", language.MustParse("en"), Default},
 		{"ru", language.MustParse("ru"), Exact},
 		{"uk", language.MustParse("uk"), Exact},
+		{"ITU-maritime", language.MustParse("en-x-maritime"), Exact},
+		{"en-x-maritime", language.MustParse("en-x-maritime"), Exact},
+		{"en-US", language.MustParse("en"), Exact},
+		{"nato", language.MustParse("en"), Exact},
+		{"en-u-va-maritime", language.MustParse("en-x-maritime"), Exact},
+		{"en-GB-x-maritime", language.MustParse("en-x-maritime"), Guess},
+		{"en-x-unknown", language.MustParse("en"), Guess},
+		{"fr-x-maritime", language.MustParse("fr"), Guess},
+		{"APCO", language.MustParse("en-x-apco"), Exact},
+		{"en-US-x-apco", language.MustParse("en-x-apco"), Exact},
+		{"Able Baker", language.MustParse("en-x-able"), Exact},
+		{"en-x-raf1942", language.MustParse("en-GB-x-raf1942"), Guess},
+		{"en-GB", language.MustParse("en-GB"), Exact},
+		{"DIN 5009:2022", language.MustParse("de-DE-x-din2022"), Exact},
+		{"de-DE-x-din2022", language.MustParse("de-DE-x-din2022"), Exact},
+		{"de-x-din2022", language.MustParse("de-DE-x-din2022"), Exact},
 	}
 
 	for _, test := range allTestCase {
@@ -92,8 +110,113 @@ func TestForLanguageCode(t *testing.T) {
 	}
 }
 
+func TestSpellingAlphabet_Variants(t *testing.T) {
+	var tags []string
+	for _, v := range English.Variants() {
+		tags = append(tags, v.LangTag())
+	}
+	if got := strings.Join(tags, " "); got != "en-x-able en-x-apco en-x-maritime" {
+		t.Errorf("Variants() = %v, want en-x-able en-x-apco en-x-maritime", got)
+	}
+	if v := BritishEnglish.Variants(); len(v) != 1 || v[0].LangTag() != "en-GB-x-raf1942" {
+		t.Errorf("Variants() = %v, want en-GB-x-raf1942", v)
+	}
+	if v := MaritimeEnglish.Variants(); v != nil {
+		t.Errorf("Variants() of a variant = %v, want none", v)
+	}
+	if v := MaritimeEnglish.Variant(); v != "maritime" {
+		t.Errorf("Variant() = %v, want maritime", v)
+	}
+	if v := English.Variant(); v != "" {
+		t.Errorf("Variant() of a default = %v, want none", v)
+	}
+}
+
 func BenchmarkSpellingAlphabet_Spell(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		All[0].Spell("Donaudampfschiffahrtsgesellschaftskapitänsmützenspitze")
 	}
 }
+
+func TestSpell_Graphemes(t *testing.T) {
+	testSpell(t, alphabet, "a\u0308t", "Ärger Theodor")
+	testSpell(t, alphabet, "e\u0301l", "'e\u0301' Ludwig")
+	testSpell(t, alphabet, "👩‍💻", "'👩‍💻'")
+}
+
+func TestSpell_Maritime(t *testing.T) {
+	testSpell(t, MaritimeEnglish, "A1.5.", "Alfa Unaone Decimal Pantafive Stop")
+	if got, unknown := MaritimeEnglish.Decode("Unaone Decimal Pantafive Stop"); got != "1.5." || unknown != nil {
+		t.Errorf("Decode() = %v %v, want 1.5.", got, unknown)
+	}
+}
+
+func TestSpell_CityGerman(t *testing.T) {
+	testSpell(t, CityGerman, "Schöß", "Salzwedel Chemnitz Hamburg Umlaut Offenbach Eszett")
+	if got, unknown := CityGerman.Decode("Köln Umlaut Aachen Salzwedel Eszett Zwo"); got != "KÄSß2" || unknown != nil {
+		t.Errorf("Decode() = %v %v, want KÄSß2", got, unknown)
+	}
+}
+
+func TestSpell_Historical(t *testing.T) {
+	tests := []struct {
+		alphabet SpellingAlphabet
+		spelled  string
+	}{
+		{APCOEnglish, "Adam Boy Charles One"},
+		{AbleBakerEnglish, "Able Baker Charlie One"},
+		{RAFEnglish, "Ace Beer Charlie One"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alphabet.LangTag(), func(t *testing.T) {
+			if got, unknown := tt.alphabet.Decode(tt.spelled); got != "ABC1" || unknown != nil {
+				t.Errorf("Decode() = %v %v, want ABC1", got, unknown)
+			}
+			if tt.alphabet.Metadata().Notes == "" {
+				t.Error("Metadata().Notes should tell the origin and era")
+			}
+		})
+	}
+	for _, lang := range []string{"en", "en-US", "und"} {
+		if a, _ := Lookup(lang); a.Variant() != "" {
+			t.Errorf("Lookup(%v) = %v, want the default alphabet", lang, a.LangTag())
+		}
+	}
+}
+
+func TestSpell_Turkish(t *testing.T) {
+	testSpell(t, Turkish, "yz", "Yozgat Zonguldak")
+	if got, unknown := Turkish.Decode("Yozgat Zonguldak"); got != "YZ" || unknown != nil {
+		t.Errorf("Decode() = %v %v, want YZ", got, unknown)
+	}
+}
+
+func TestSpell_Polish(t *testing.T) {
+	testSpell(t, Polish, "dżem", "Dżem Ewa Marek")
+	testSpell(t, Polish, "Dźwig", "Dźwig Wacław Irena Grażyna")
+	testSpell(t, Polish, "dzwon", "Dzwon Wacław Olga Natalia")
+	testSpell(t, Polish, "Rzeszów", "Rzeka Ewa Szymon Ósemka Wacław")
+	testSpell(t, Polish, "ząb", "Zygmunt a z ogonkiem Barbara")
+
+	want := []Token{{"dż", "Dżem", Letter}, {"ż", "Żaba", Letter}}
+	got := Polish.Tokenize("dżż")
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Tokenize() = %v, want %v", got, want)
+	}
+	if got, unknown := Polish.Decode("Dżem Ewa Marek"); got != "DŻEM" || unknown != nil {
+		t.Errorf("Decode() = %v %v, want DŻEM", got, unknown)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	want := []Token{{"Sch", "Schule", Letter}, {"a", "Anton", Letter}, {"?", "'?'", Symbol}}
+	got := alphabet.Tokenize("Scha?")
+	if len(got) != len(want) {
+		t.Fatalf("Tokenize() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Tokenize()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
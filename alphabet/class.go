@@ -0,0 +1,82 @@
+package alphabet
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Class is a class of characters, which are selected for spelling.
+type Class int
+
+const (
+	Letters    Class = iota // tokens of Kind Letter
+	Digits                  // tokens of Kind Digit
+	Symbols                 // tokens of Kind Symbol
+	Confusable              // characters, which are easily confused with others, like "0" and "O"
+	NonASCII                // tokens with characters outside of ASCII, like "ä" or "€"
+)
+
+var classNames = []string{"letters", "digits", "symbols", "confusable", "non-ascii"}
+
+func (c Class) String() string {
+	return classNames[c]
+}
+
+// ParseClass returns the Class with name, like "digits".
+func ParseClass(name string) (Class, error) {
+	for i, n := range classNames {
+		if n == name {
+			return Class(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown class '%s'", name)
+}
+
+// Contains reports whether the Text of t is in c.
+func (c Class) Contains(t Token) bool {
+	switch c {
+	case Letters:
+		return t.Kind == Letter
+	case Digits:
+		return t.Kind == Digit
+	case Symbols:
+		return t.Kind == Symbol
+	case Confusable:
+		for _, groups := range ambiguous {
+			if ambiguousGroup(groups, t.Text) != nil {
+				return true
+			}
+		}
+		return false
+	case NonASCII:
+		return strings.IndexFunc(t.Text, func(r rune) bool { return r > unicode.MaxASCII }) >= 0
+	}
+	return false
+}
+
+// Select keeps the tokens, which are selected, and merges all others into literal tokens.
+//
+// A literal token is said as written: its Word is its Text in plain words, like "Invoice" of "Invoice 4711".
+// Literal tokens of spaces only have an empty Word.
+func Select(tokens []Token, selected func(t Token) bool) []Token {
+	var result []Token
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			text := literal.String()
+			result = append(result, Token{text, strings.Join(strings.Fields(text), " "), KindOf(text)})
+			literal.Reset()
+		}
+	}
+	for _, t := range tokens {
+		if selected(t) {
+			flush()
+			result = append(result, t)
+		} else {
+			literal.WriteString(t.Text)
+		}
+	}
+	flush()
+	return result
+}
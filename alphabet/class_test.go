@@ -0,0 +1,67 @@
+package alphabet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseClass(t *testing.T) {
+	for _, name := range classNames {
+		c, err := ParseClass(name)
+		if err != nil || c.String() != name {
+			t.Errorf("ParseClass(%v) = %v, %v", name, c, err)
+		}
+	}
+	if _, err := ParseClass("words"); err == nil {
+		t.Error("ParseClass(words) should fail")
+	}
+}
+
+func TestClass_Contains(t *testing.T) {
+	tests := []struct {
+		class Class
+		text  string
+		want  bool
+	}{
+		{Letters, "sch", true},
+		{Letters, "1", false},
+		{Digits, "1", true},
+		{Symbols, "?", true},
+		{Symbols, " ", true},
+		{Confusable, "0", true},
+		{Confusable, "a", false},
+		{NonASCII, "ä", true},
+		{NonASCII, "a", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.class.String()+" "+tt.text, func(t *testing.T) {
+			if got := tt.class.Contains(Token{tt.text, "", KindOf(tt.text)}); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		text  string
+		class Class
+		want  string
+	}{
+		{"Invoice 4711", Digits, "Invoice Four Seven One One"},
+		{"4711 4712", Digits, "Four Seven One One  Four Seven One Two"},
+		{"A-1", Symbols, "A Dash 1"},
+		{"Grüße", NonASCII, "Gr 'ü' 'ß' e"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var words []string
+			for _, token := range Select(English.Tokenize(tt.text), tt.class.Contains) {
+				words = append(words, token.Word)
+			}
+			if got := strings.Join(words, " "); got != tt.want {
+				t.Errorf("Select() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
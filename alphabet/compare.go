@@ -0,0 +1,84 @@
+package alphabet
+
+import (
+	"golang.org/x/text/collate"
+	"sort"
+	"strings"
+)
+
+// Status tells how the words of a key differ between spelling alphabets.
+type Status int
+
+const (
+	Same      Status = iota // all alphabets spell the key with the same word
+	Different               // all alphabets have the key, but spell it with different words
+	Missing                 // only some alphabets have the key
+)
+
+var statusNames = []string{"Same", "Different", "Missing"}
+
+func (s Status) String() string {
+	return statusNames[s]
+}
+
+// MarshalText encodes s in lower case, like "same".
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(s.String())), nil
+}
+
+// Comparison compares the words of one key in several spelling alphabets.
+type Comparison struct {
+	Entry
+	// Words of the key in each compared alphabet, in the order of the alphabets. A missing key has an empty word.
+	Words []string `json:"words"`
+	// Status summarizes the Words.
+	Status Status `json:"status"`
+}
+
+// Compare compares alphabets key by key.
+//
+// It returns one Comparison for each key of any of the alphabets. The Entry of a Comparison is the one of the
+// first alphabet with the key. Comparisons are ordered like Entries, using the collation order of the first alphabet.
+func Compare(alphabets ...SpellingAlphabet) []Comparison {
+	index := make(map[string]int)
+	var comparisons []Comparison
+	for i, a := range alphabets {
+		for _, e := range a.Entries() {
+			n, ok := index[e.Key]
+			if !ok {
+				n = len(comparisons)
+				index[e.Key] = n
+				comparisons = append(comparisons, Comparison{Entry: e, Words: make([]string, len(alphabets))})
+			}
+			comparisons[n].Words[i] = e.Word
+		}
+	}
+
+	for i := range comparisons {
+		comparisons[i].Status = compareWords(comparisons[i].Words)
+	}
+
+	if len(alphabets) > 0 {
+		c := collate.New(alphabets[0].lang)
+		sort.SliceStable(comparisons, func(i, j int) bool {
+			if comparisons[i].Kind != comparisons[j].Kind {
+				return comparisons[i].Kind < comparisons[j].Kind
+			}
+			return c.CompareString(comparisons[i].Key, comparisons[j].Key) < 0
+		})
+	}
+	return comparisons
+}
+
+func compareWords(words []string) Status {
+	status := Same
+	for _, word := range words {
+		if word == "" {
+			return Missing
+		}
+		if word != words[0] {
+			status = Different
+		}
+	}
+	return status
+}
@@ -0,0 +1,44 @@
+package alphabet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	a := SpellingAlphabet{m: map[string]string{"a": "Anton", "b": "Berta", "ch": "Charlotte", "1": "Eins"}}
+	b := SpellingAlphabet{m: map[string]string{"a": "Anton", "b": "Bruno", "ä": "Äsch", "1": "Eins"}}
+
+	want := []struct {
+		key    string
+		words  []string
+		status Status
+	}{
+		{"a", []string{"Anton", "Anton"}, Same},
+		{"ä", []string{"", "Äsch"}, Missing},
+		{"b", []string{"Berta", "Bruno"}, Different},
+		{"ch", []string{"Charlotte", ""}, Missing},
+		{"1", []string{"Eins", "Eins"}, Same},
+	}
+
+	got := Compare(a, b)
+	if len(got) != len(want) {
+		t.Fatalf("Compare() returned %d comparisons, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Key != w.key || !reflect.DeepEqual(got[i].Words, w.words) || got[i].Status != w.status {
+			t.Errorf("Compare()[%d] = %v %v %v, want %v %v %v", i, got[i].Key, got[i].Words, got[i].Status, w.key, w.words, w.status)
+		}
+	}
+}
+
+func TestCompare_Lang(t *testing.T) {
+	for _, c := range Compare(German, AustrianGerman) {
+		if c.Key == "k" && c.Status != Different {
+			t.Errorf("Compare() status of 'k' = %v, want %v", c.Status, Different)
+		}
+		if c.Key == "a" && c.Status != Same {
+			t.Errorf("Compare() status of 'a' = %v, want %v", c.Status, Same)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package alphabet
+
+// confusables are classes of Latin, Cyrillic, Greek and Armenian letters, which look alike, by the confusables data of
+// Unicode Technical Standard #39. It is a reviewed subset of the data, which go generate replaces with all classes.
+var confusables = [][]rune{
+	{'A', 'Α', 'А'},
+	{'B', 'Β', 'В'},
+	{'C', 'Ϲ', 'С'},
+	{'E', 'Ε', 'Е'},
+	{'F', 'Ϝ'},
+	{'G', 'Ԍ'},
+	{'H', 'Η', 'Н'},
+	{'J', 'Ϳ', 'Ј'},
+	{'K', 'Κ', 'К'},
+	{'M', 'Μ', 'Ϻ', 'М'},
+	{'N', 'Ν'},
+	{'O', 'Ο', 'О', 'Օ'},
+	{'P', 'Ρ', 'Р'},
+	{'Q', 'Ԛ'},
+	{'S', 'Ѕ', 'Տ'},
+	{'T', 'Τ', 'Т'},
+	{'U', 'Ս'},
+	{'V', 'Ѵ'},
+	{'W', 'Ԝ'},
+	{'X', 'Χ', 'Х'},
+	{'Y', 'Υ', 'Ү'},
+	{'Z', 'Ζ'},
+	{'a', 'ɑ', 'α', 'а'},
+	{'b', 'Ƅ'},
+	{'c', 'ϲ', 'с', 'ᴄ'},
+	{'d', 'ԁ'},
+	{'e', 'е', 'ҽ'},
+	{'f', 'ſ'},
+	{'g', 'ƍ', 'ɡ', 'ԍ', 'ց'},
+	{'h', 'һ', 'հ'},
+	{'i', 'ı', 'ɩ', 'ι', 'і'},
+	{'j', 'ϳ', 'ј'},
+	{'l', 'I', 'Ɩ', 'ǀ', 'Ι', 'І', 'Ӏ', 'ӏ'},
+	{'n', 'ո'},
+	{'o', 'ο', 'σ', 'о', 'օ', 'ᴏ'},
+	{'p', 'ρ', 'ϱ', 'р'},
+	{'q', 'ԛ', 'գ', 'զ'},
+	{'r', 'г'},
+	{'s', 'ƽ', 'ѕ', 'ꜱ'},
+	{'u', 'ʋ', 'υ', 'ս', 'ᴜ'},
+	{'v', 'ν', 'ѵ', 'ᴠ'},
+	{'w', 'ɯ', 'ѡ', 'ԝ', 'ա', 'ᴡ'},
+	{'x', 'х'},
+	{'y', 'ɣ', 'ʏ', 'γ', 'у', 'ү'},
+	{'z', 'ᴢ'},
+}
@@ -0,0 +1,122 @@
+package alphabet
+
+import (
+	"strings"
+)
+
+// UnknownWord is a word, which could not be decoded.
+type UnknownWord struct {
+	// Pos is the position of Word in the spelled text, counting words from 1.
+	Pos int
+	// Word is the word, which could not be decoded.
+	Word string
+}
+
+// Decode returns the text spelled by the phonetic forms of sa.
+//
+// Decode matches words case insensitive to the phonetic forms of sa.
+// Phonetic forms of several words, like "Question Mark", are matched as a whole and phonetic forms with alternatives,
+// like "Anna/Anton", match each alternative.
+// Letters are decoded in upper case. Quoted characters like "'€'", which Spell writes for unmapped characters,
+// are decoded to the quoted character.
+// Decode returns all words, which it could not decode.
+func (sa SpellingAlphabet) Decode(spelled string) (string, []UnknownWord) {
+	index, maxWords := sa.reverseIndex()
+	words := strings.Fields(spelled)
+
+	var sb strings.Builder
+	var unknown []UnknownWord
+	for i := 0; i < len(words); {
+		if key, n := sa.decodeFirstMatch(index, maxWords, words[i:]); n > 0 {
+			sb.WriteString(sa.upper(key))
+			i += n
+		} else if char, n := decodeQuoted(words[i:]); n > 0 {
+			sb.WriteString(char)
+			i += n
+		} else {
+			unknown = append(unknown, UnknownWord{Pos: i + 1, Word: words[i]})
+			i++
+		}
+	}
+	return sb.String(), unknown
+}
+
+// reverseIndex maps the lower case phonetic forms of sa to their key.
+// It returns the index together with the maximum number of words of an indexed phonetic form.
+func (sa SpellingAlphabet) reverseIndex() (map[string]string, int) {
+	index := make(map[string]string, len(sa.m))
+	var maxWords int
+	for key, value := range sa.m {
+		alternatives := strings.Split(value, "/")
+		if len(alternatives) > 1 {
+			alternatives = append(alternatives, value)
+		}
+		for _, alternative := range alternatives {
+			words := strings.Fields(sa.lower(alternative))
+			if len(words) == 0 {
+				continue
+			}
+			phrase := strings.Join(words, " ")
+			// Prefer the shortest key for ambiguous phonetic forms, so Decode is deterministic.
+			if other, ok := index[phrase]; ok && (len(other) < len(key) || len(other) == len(key) && other < key) {
+				continue
+			}
+			index[phrase] = key
+			if maxWords < len(words) {
+				maxWords = len(words)
+			}
+		}
+	}
+	if phrase := sa.lower(sa.decimal); phrase != "" {
+		if _, ok := index[phrase]; !ok {
+			index[phrase] = "."
+		}
+	}
+	return index, maxWords
+}
+
+// decodeFirstMatch decodes the longest phrase at the beginning of words.
+// It returns the key of the phrase and the number of words in it, or 0 if there is no match.
+func (sa SpellingAlphabet) decodeFirstMatch(index map[string]string, maxWords int, words []string) (string, int) {
+	n := maxWords
+	if n > len(words) {
+		n = len(words)
+	}
+	for ; n > 0; n-- {
+		if key, ok := index[sa.lower(strings.Join(words[:n], " "))]; ok {
+			return key, n
+		}
+	}
+	return "", 0
+}
+
+// decodeQuoted decodes a character or grapheme cluster, which Spell quotes because it is unmapped.
+// It returns the character and the number of words it used, or 0 if words does not start with a quoted character.
+func decodeQuoted(words []string) (string, int) {
+	word := words[0]
+	if len(words) > 1 && word == "'" && words[1] == "'" {
+		// A quoted space is split into two words.
+		return " ", 2
+	}
+	if len(word) > 2 && strings.HasPrefix(word, "'") && strings.HasSuffix(word, "'") {
+		char := word[1 : len(word)-1]
+		if len(Graphemes(char)) == 1 {
+			return char, 1
+		}
+	}
+	return "", 0
+}
+
+func (sa SpellingAlphabet) lower(s string) string {
+	if sa.c == nil {
+		return strings.ToLower(s)
+	}
+	return strings.ToLowerSpecial(*sa.c, s)
+}
+
+func (sa SpellingAlphabet) upper(s string) string {
+	if sa.c == nil {
+		return strings.ToUpper(s)
+	}
+	return strings.ToUpperSpecial(*sa.c, s)
+}
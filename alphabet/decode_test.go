@@ -0,0 +1,64 @@
+package alphabet
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecode_Lang(t *testing.T) {
+	for _, a := range All {
+		t.Run(a.lang.String(), func(t *testing.T) {
+			for key, value := range a.m {
+				if got, unknown := a.Decode(value); got != a.upper(key) || len(unknown) != 0 {
+					t.Errorf("Decode(%q) = %q %v, want %q", value, got, unknown, a.upper(key))
+				}
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		alphabet    SpellingAlphabet
+		spelled     string
+		want        string
+		wantUnknown []UnknownWord
+	}{
+		{English, "Alfa Bravo One Two", "AB12", nil},
+		{English, "alfa  BRAVO question mark", "AB?", nil},
+		{English, "Alfa Space Bravo", "A B", nil},
+		{English, "Alfa '€' ' ' Bravo", "A€ B", nil},
+		{English, "'e\u0301' '👩‍💻'", "e\u0301👩‍💻", nil},
+		{German, "Schule Ludwig Anton Charlotte Theodor", "SCHLACHT", nil},
+		{German, "Runde Klammer links Eins Runde Klammer rechts", "(1)", nil},
+		{Dutch, "Anna Anton Anna/Anton", "AAA", nil},
+		{Turkish, "İzmir Isparta", "İI", nil},
+		{English, "Alfa Bravado Charlie Klammer", "AC", []UnknownWord{{2, "Bravado"}, {4, "Klammer"}}},
+		{English, "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spelled, func(t *testing.T) {
+			got, unknown := tt.alphabet.Decode(tt.spelled)
+			if got != tt.want {
+				t.Errorf("Decode() = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(unknown, tt.wantUnknown) {
+				t.Errorf("Decode() unknown = %v, want %v", unknown, tt.wantUnknown)
+			}
+		})
+	}
+}
+
+func TestDecode_Unambiguous(t *testing.T) {
+	for _, a := range All {
+		keys := make(map[string]string)
+		for key, word := range a.m {
+			word = strings.ToLower(word)
+			if other, ok := keys[word]; ok {
+				t.Errorf("%v spells both '%s' and '%s' as %s, so Decode cannot tell them apart", a.LangTag(), key, other, word)
+			}
+			keys[word] = key
+		}
+	}
+}
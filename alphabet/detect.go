@@ -0,0 +1,49 @@
+package alphabet
+
+import (
+	"golang.org/x/text/language"
+	"sort"
+	"strings"
+)
+
+// Detection is the score of a SpellingAlphabet for a spelled text.
+type Detection struct {
+	Alphabet SpellingAlphabet
+	// Score is the share of words in the spelled text, which Alphabet decodes. It is between 0 and 1.
+	Score float64
+}
+
+// Detect scores all SpellingAlphabets by how many words of spelled they can decode.
+//
+// The Detections are sorted by descending score.
+// Equal scores prefer SpellingAlphabets for the default region of their language, e.g. de-DE over de-AT.
+// All remaining ties keep the order of All.
+func Detect(spelled string) []Detection {
+	words := len(strings.Fields(spelled))
+
+	detections := make([]Detection, 0, len(All))
+	for _, a := range All {
+		var score float64
+		if words > 0 {
+			_, unknown := a.Decode(spelled)
+			score = float64(words-len(unknown)) / float64(words)
+		}
+		detections = append(detections, Detection{a, score})
+	}
+
+	sort.SliceStable(detections, func(i, j int) bool {
+		if detections[i].Score != detections[j].Score {
+			return detections[i].Score > detections[j].Score
+		}
+		return detections[i].Alphabet.isDefaultRegion() && !detections[j].Alphabet.isDefaultRegion()
+	})
+	return detections
+}
+
+// isDefaultRegion reports whether sa is used in the most likely region of its language.
+func (sa SpellingAlphabet) isDefaultRegion() bool {
+	region, _ := sa.lang.Region()
+	base, _ := sa.lang.Base()
+	defaultRegion, _ := language.Make(base.String()).Region()
+	return region == defaultRegion
+}
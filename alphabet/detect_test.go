@@ -0,0 +1,44 @@
+package alphabet
+
+import (
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		spelled    string
+		want       []string
+		wantScores []float64
+	}{
+		{"Anton Berta Cäsar", []string{"de-DE", "de-AT", "de-CH"}, []float64{1, 1, 2.0 / 3}},
+		{"Alfred Benjamin", []string{"en-GB"}, []float64{1}},
+		{"Alfa Bravo Unknown", []string{"en", "en-x-maritime"}, []float64{2.0 / 3, 2.0 / 3}},
+		{"Unaone Decimal Pantafive", []string{"en-x-maritime"}, []float64{1}},
+		{"Adana Bolu Zonguldak", []string{"tr"}, []float64{1}},
+		{"Анна Борис", []string{"ru"}, []float64{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spelled, func(t *testing.T) {
+			got := Detect(tt.spelled)
+			if len(got) != len(All) {
+				t.Fatalf("Detect() returned %d detections, want one for each of %d alphabets", len(got), len(All))
+			}
+			for i := range tt.want {
+				if got[i].Alphabet.LangTag() != tt.want[i] || got[i].Score != tt.wantScores[i] {
+					t.Errorf("Detect()[%d] = %v %v, want %v %v", i, got[i].Alphabet.LangTag(), got[i].Score, tt.want[i], tt.wantScores[i])
+				}
+			}
+			if next := got[len(tt.want)]; next.Score >= tt.wantScores[len(tt.want)-1] {
+				t.Errorf("Detect()[%d] = %v %v should have a lower score", len(tt.want), next.Alphabet.LangTag(), next.Score)
+			}
+		})
+	}
+}
+
+func TestDetect_Empty(t *testing.T) {
+	for _, d := range Detect("") {
+		if d.Score != 0 {
+			t.Errorf("Detect() of nothing scored %v with %v", d.Alphabet.LangTag(), d.Score)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package alphabet
+
+import (
+	"golang.org/x/text/collate"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind classifies the keys of a SpellingAlphabet.
+type Kind int
+
+const (
+	Letter Kind = iota // letters and letter groups like "sch"
+	Digit              // decimal digits
+	Symbol             // all other characters like punctuation and space
+)
+
+var kindNames = []string{"Letter", "Digit", "Symbol"}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// MarshalText encodes k in lower case, like "letter".
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(k.String())), nil
+}
+
+// KindOf returns the Kind of key, which is classified by its first character.
+func KindOf(key string) Kind {
+	r, _ := utf8.DecodeRuneInString(key)
+	switch {
+	case unicode.IsLetter(r):
+		return Letter
+	case unicode.IsDigit(r):
+		return Digit
+	default:
+		return Symbol
+	}
+}
+
+// Entry is a key of a SpellingAlphabet together with its phonetic form.
+type Entry struct {
+	// Key in lower case.
+	Key string `json:"key"`
+	// Upper is the Key in upper case, following the case mappings of the language.
+	Upper string `json:"upper"`
+	// Word is the phonetic form of the Key.
+	Word string `json:"word"`
+	// Kind of the Key.
+	Kind Kind `json:"kind"`
+}
+
+// Entries returns all entries of sa, grouped by their Kind in the order letters, digits and symbols.
+//
+// Entries of the same Kind are sorted in the collation order of the language of sa,
+// so "ä" follows "a" in German and "ç" follows "c" in Turkish.
+func (sa SpellingAlphabet) Entries() []Entry {
+	entries := make([]Entry, 0, len(sa.m))
+	for key, word := range sa.m {
+		entries = append(entries, Entry{key, sa.upper(key), word, KindOf(key)})
+	}
+
+	c := collate.New(sa.lang)
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Kind != entries[j].Kind {
+			return entries[i].Kind < entries[j].Kind
+		}
+		if order := c.CompareString(entries[i].Key, entries[j].Key); order != 0 {
+			return order < 0
+		}
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
@@ -0,0 +1,68 @@
+package alphabet
+
+import (
+	"testing"
+)
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		key  string
+		want Kind
+	}{
+		{"a", Letter},
+		{"sch", Letter},
+		{"ж", Letter},
+		{"7", Digit},
+		{"?", Symbol},
+		{" ", Symbol},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := KindOf(tt.key); got != tt.want {
+				t.Errorf("KindOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntries(t *testing.T) {
+	tests := []struct {
+		a    SpellingAlphabet
+		want []string
+	}{
+		{alphabet, []string{"a", "ä", "ch", "l", "sch", "t"}},
+		{SpellingAlphabet{m: map[string]string{"1": "One", "?": "Question Mark", "b": "Bravo", "a": "Alfa"}}, []string{"a", "b", "1", "?"}},
+		{Turkish, []string{"a", "b", "c", "ç", "d", "e", "f", "g", "ğ", "h", "ı", "i"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.LangTag(), func(t *testing.T) {
+			got := tt.a.Entries()
+			if len(got) != len(tt.a.m) {
+				t.Fatalf("Entries() returned %d entries, want %d", len(got), len(tt.a.m))
+			}
+			for i, key := range tt.want {
+				if got[i].Key != key {
+					t.Errorf("Entries()[%d].Key = %v, want %v", i, got[i].Key, key)
+				}
+			}
+		})
+	}
+}
+
+func TestEntries_Upper(t *testing.T) {
+	for _, e := range Turkish.Entries() {
+		if e.Key == "i" && e.Upper != "İ" {
+			t.Errorf("Entries() upper case of 'i' = %v, want İ", e.Upper)
+		}
+		if e.Word != Turkish.m[e.Key] {
+			t.Errorf("Entries() word of '%s' = %v, want %v", e.Key, e.Word, Turkish.m[e.Key])
+		}
+	}
+}
+
+func TestKind_MarshalText(t *testing.T) {
+	got, err := Symbol.MarshalText()
+	if err != nil || string(got) != "symbol" {
+		t.Errorf("MarshalText() = %s, %v, want symbol", got, err)
+	}
+}
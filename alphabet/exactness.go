@@ -3,6 +3,7 @@ package alphabet
 import (
 	"fmt"
 	"golang.org/x/text/language"
+	"strings"
 )
 
 // Exactness indicates the level of certainty for a given return value.
@@ -22,6 +23,11 @@ func (c Exactness) String() string {
 	return confName[c]
 }
 
+// MarshalText encodes c in lower case, like "exact".
+func (c Exactness) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(c.String())), nil
+}
+
 func FromLangConfidence(c language.Confidence) Exactness {
 	switch c {
 	case language.No:
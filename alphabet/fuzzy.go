@@ -0,0 +1,236 @@
+package alphabet
+
+import (
+	"golang.org/x/text/unicode/norm"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// minConfidence is the lowest confidence DecodeFuzzy accepts as match.
+	minConfidence = 0.55
+	// ambiguity is the maximal difference in confidence of an alternative to the best Candidate.
+	ambiguity = 0.1
+	// maxAlternatives is the maximal number of alternatives DecodeFuzzy suggests.
+	maxAlternatives = 3
+)
+
+// Candidate is a key, which was possibly spelled, together with the confidence of this match.
+type Candidate struct {
+	// Key of the SpellingAlphabet in upper case.
+	Key string
+	// Confidence is a value between 0 for no similarity and 1 for an exact match.
+	Confidence float64
+}
+
+// FuzzyMatch is a key decoded from one or more words of a transcript.
+type FuzzyMatch struct {
+	// Pos is the position of the first word in the transcript, counting words from 1.
+	Pos int
+	// Words of the transcript, which were decoded.
+	Words string
+	// Candidate is the best matching key. Its Key is empty, if no key matches the Words.
+	Candidate
+	// Alternatives are further candidates, which match the Words almost as well as the best Candidate.
+	Alternatives []Candidate
+}
+
+// fuzzyEntry is a phonetic form of a SpellingAlphabet prepared for fuzzy matching.
+type fuzzyEntry struct {
+	key        string
+	normalized string
+	phonetic   string
+}
+
+// DecodeFuzzy decodes a transcript of spelled text, like the output of a speech recognition.
+//
+// Other than Decode, DecodeFuzzy also decodes words which are similar to a phonetic form.
+// It compares normalized words, ignoring case, diacritics, punctuation and spaces, so "x ray" matches "X-ray".
+// Common variants of phonetic forms match like the phonetic forms themselves, like "Alpha" for "Alfa" or "Niner" for
+// "Nine" in English.
+// The similarity of two words combines their edit distance and the similarity of their pronunciation.
+// Pronunciation is encoded by Cologne phonetics for German and Metaphone for all other languages in Latin script.
+//
+// DecodeFuzzy returns one FuzzyMatch for each decoded key. Words without a match return a FuzzyMatch with an empty Key.
+func (sa SpellingAlphabet) DecodeFuzzy(transcript string) []FuzzyMatch {
+	index, maxWords := sa.reverseIndex()
+	code := sa.phoneticCode()
+	entries := make([]fuzzyEntry, 0, len(index))
+	for phrase, key := range index {
+		normalized := normalizeWord(phrase)
+		entries = append(entries, fuzzyEntry{sa.upper(key), normalized, encode(code, normalized)})
+	}
+	for alias, key := range sa.aliases {
+		normalized := normalizeWord(alias)
+		entries = append(entries, fuzzyEntry{sa.upper(key), normalized, encode(code, normalized)})
+	}
+	// Transcripts often split words, so a match may span one word more than the longest phonetic form.
+	maxWords++
+
+	words := strings.Fields(transcript)
+	var matches []FuzzyMatch
+	for i := 0; i < len(words); {
+		if key, n := sa.decodeFirstMatch(index, maxWords, words[i:]); n > 0 {
+			matches = append(matches, FuzzyMatch{Pos: i + 1, Words: strings.Join(words[i:i+n], " "), Candidate: Candidate{sa.upper(key), 1}})
+			i += n
+			continue
+		}
+		if char, n := decodeQuoted(words[i:]); n > 0 {
+			matches = append(matches, FuzzyMatch{Pos: i + 1, Words: strings.Join(words[i:i+n], " "), Candidate: Candidate{char, 1}})
+			i += n
+			continue
+		}
+
+		var best []Candidate
+		bestWords := 1
+		for n := 1; n <= maxWords && i+n <= len(words); n++ {
+			candidates := rankCandidates(entries, code, strings.Join(words[i:i+n], " "))
+			if len(candidates) > 0 && (len(best) == 0 || candidates[0].Confidence > best[0].Confidence) {
+				best = candidates
+				bestWords = n
+			}
+		}
+
+		match := FuzzyMatch{Pos: i + 1, Words: strings.Join(words[i:i+bestWords], " ")}
+		if len(best) > 0 {
+			match.Candidate = best[0]
+			match.Alternatives = best[1:]
+		}
+		matches = append(matches, match)
+		i += bestWords
+	}
+	return matches
+}
+
+// rankCandidates returns the candidates matching words, sorted by descending confidence.
+// The first candidate is the best match. All further candidates are within the ambiguity of the best match.
+func rankCandidates(entries []fuzzyEntry, code phoneticCode, words string) []Candidate {
+	normalized := normalizeWord(words)
+	if normalized == "" {
+		return nil
+	}
+	phonetic := encode(code, normalized)
+
+	confidence := make(map[string]float64)
+	for _, e := range entries {
+		c := similarity(normalized, e.normalized)
+		if normalized != e.normalized {
+			// Only exact matches are fully confident.
+			c = 0.9 * (0.6*c + 0.4*similarity(phonetic, e.phonetic))
+		} else {
+			c = 0.95
+		}
+		if c > confidence[e.key] {
+			confidence[e.key] = c
+		}
+	}
+
+	var candidates []Candidate
+	for key, c := range confidence {
+		if c >= minConfidence {
+			candidates = append(candidates, Candidate{key, c})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Confidence != candidates[j].Confidence {
+			return candidates[i].Confidence > candidates[j].Confidence
+		}
+		return candidates[i].Key < candidates[j].Key
+	})
+
+	n := 1
+	for n < len(candidates) && n <= maxAlternatives && candidates[0].Confidence-candidates[n].Confidence <= ambiguity {
+		n++
+	}
+	if n > len(candidates) {
+		n = len(candidates)
+	}
+	return candidates[:n]
+}
+
+// phoneticCode returns the phonetic algorithm for the language of sa or nil, if there is none.
+func (sa SpellingAlphabet) phoneticCode() phoneticCode {
+	base, _ := sa.lang.Base()
+	if base.String() == "de" {
+		return cologne
+	}
+	if script, _ := sa.lang.Script(); script.String() == "Latn" {
+		return metaphone
+	}
+	return nil
+}
+
+func encode(code phoneticCode, normalized string) string {
+	if code == nil || !isASCIILetters(normalized) {
+		return normalized
+	}
+	return code(normalized)
+}
+
+func isASCIILetters(s string) bool {
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeWord returns s in lower case without diacritics and characters other than letters and digits.
+func normalizeWord(s string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		switch {
+		case r == 'ß':
+			sb.WriteString("ss")
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// similarity returns 1 minus the edit distance of a and b relative to the length of the longer one.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the minimal number of inserted, deleted and substituted runes to change a into b.
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(first int, others ...int) int {
+	m := first
+	for _, o := range others {
+		if o < m {
+			m = o
+		}
+	}
+	return m
+}
@@ -0,0 +1,80 @@
+package alphabet
+
+import (
+	"testing"
+)
+
+func TestDecodeFuzzy(t *testing.T) {
+	tests := []struct {
+		alphabet SpellingAlphabet
+		spelled  string
+		want     []string
+	}{
+		{English, "alpha juliet niner x ray bravado", []string{"A", "J", "9", "X", "B"}},
+		{English, "Alfa Question Mark '€'", []string{"A", "?", "€"}},
+		{German, "Antonn Zäsar Fridrich Schule", []string{"A", "C", "F", "SCH"}},
+		{English, "Alfa Zzzzzz", []string{"A", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spelled, func(t *testing.T) {
+			matches := tt.alphabet.DecodeFuzzy(tt.spelled)
+			if len(matches) != len(tt.want) {
+				t.Fatalf("DecodeFuzzy() = %v, want keys %v", matches, tt.want)
+			}
+			for i, m := range matches {
+				if m.Key != tt.want[i] {
+					t.Errorf("DecodeFuzzy()[%d] = %v, want key %v", i, m, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeFuzzy_Confidence(t *testing.T) {
+	matches := English.DecodeFuzzy("Alfa x ray bravado")
+	if len(matches) != 3 {
+		t.Fatalf("DecodeFuzzy() = %v, want 3 matches", matches)
+	}
+	if matches[0].Confidence != 1 {
+		t.Errorf("Exact match %v should have full confidence", matches[0])
+	}
+	if m := matches[1]; m.Pos != 2 || m.Words != "x ray" || m.Confidence >= 1 || m.Confidence < 0.9 {
+		t.Errorf("Normalized match %v should start at word 2 and have a confidence between 0.9 and 1", m)
+	}
+	if m := matches[2]; m.Pos != 4 || m.Confidence >= matches[1].Confidence {
+		t.Errorf("Similar match %v should start at word 4 and have less confidence than a normalized match", m)
+	}
+}
+
+func TestDecodeFuzzy_Aliases(t *testing.T) {
+	tests := []struct {
+		alphabet SpellingAlphabet
+		spelled  string
+		want     string
+	}{
+		{English, "alpha", "A"},
+		{English, "Juliet", "J"},
+		{English, "niner", "9"},
+		{English, "whisky", "W"},
+		{MaritimeEnglish, "Alpha", "A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spelled, func(t *testing.T) {
+			matches := tt.alphabet.DecodeFuzzy(tt.spelled)
+			if len(matches) != 1 || matches[0].Key != tt.want || matches[0].Confidence != 0.95 {
+				t.Errorf("DecodeFuzzy() = %v, want %v with confidence 0.95", matches, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeFuzzy_Alternatives(t *testing.T) {
+	matches := English.DecodeFuzzy("fine")
+	if len(matches) != 1 {
+		t.Fatalf("DecodeFuzzy() = %v, want 1 match", matches)
+	}
+	m := matches[0]
+	if m.Key != "5" || len(m.Alternatives) != 1 || m.Alternatives[0].Key != "9" {
+		t.Errorf("DecodeFuzzy() = %v, want 5 with alternative 9", m)
+	}
+}
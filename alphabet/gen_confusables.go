@@ -0,0 +1,161 @@
+//go:build ignore
+// +build ignore
+
+// gen_confusables writes confusables_table.go with the letters, which look alike, from the confusables data of
+// Unicode Technical Standard #39.
+//
+// Usage:
+//
+//	go run gen_confusables.go [-in confusables.txt] [-out confusables_table.go]
+//
+// Without -in, the latest confusables.txt is downloaded from unicode.org.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const url = "https://www.unicode.org/Public/security/latest/confusables.txt"
+
+// scripts, whose letters are compared. They are the scripts of homoglyph.go.
+var scripts = []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic, unicode.Greek, unicode.Armenian}
+
+func main() {
+	in := flag.String("in", "", "Read `confusables.txt` from a file instead of downloading it")
+	out := flag.String("out", "confusables_table.go", "Write the table to `file`")
+	flag.Parse()
+
+	r, err := open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	version, classes, err := parse(r)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(version, classes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func open(file string) (io.ReadCloser, error) {
+	if file != "" {
+		return os.Open(file)
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// parse reads the version of confusables.txt and the classes of letters, which share a prototype of a single code
+// point. A class starts with its prototype, if it is a letter, followed by the other letters in code point order.
+func parse(r io.Reader) (string, [][]rune, error) {
+	var version string
+	sources := make(map[rune][]rune)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		if strings.HasPrefix(line, "# Version: ") {
+			version = strings.TrimPrefix(line, "# Version: ")
+		}
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		source, err := parseRunes(fields[0])
+		if err != nil {
+			return "", nil, err
+		}
+		prototype, err := parseRunes(fields[1])
+		if err != nil {
+			return "", nil, err
+		}
+		if len(source) == 1 && len(prototype) == 1 {
+			sources[prototype[0]] = append(sources[prototype[0]], source[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, err
+	}
+
+	var classes [][]rune
+	for prototype, letters := range sources {
+		sort.Slice(letters, func(i, j int) bool { return letters[i] < letters[j] })
+		var class []rune
+		for _, r := range append([]rune{prototype}, letters...) {
+			if isScriptLetter(r) {
+				class = append(class, r)
+			}
+		}
+		if len(class) > 1 {
+			classes = append(classes, class)
+		}
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i][0] < classes[j][0] })
+	return version, classes, nil
+}
+
+// parseRunes parses code points in hex separated by spaces, like "0072 006E".
+func parseRunes(s string) ([]rune, error) {
+	var runes []rune
+	for _, f := range strings.Fields(s) {
+		r, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code point %q: %v", f, err)
+		}
+		runes = append(runes, rune(r))
+	}
+	return runes, nil
+}
+
+func isScriptLetter(r rune) bool {
+	return unicode.IsLetter(r) && unicode.In(r, scripts...)
+}
+
+func generate(version string, classes [][]rune) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gen_confusables.go. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package alphabet")
+	fmt.Fprintln(&b)
+	fmt.Fprintf(&b, "// confusables are classes of Latin, Cyrillic, Greek and Armenian letters, which look alike, by the confusables\n")
+	fmt.Fprintf(&b, "// data of Unicode Technical Standard #39, version %s.\n", version)
+	fmt.Fprintln(&b, "var confusables = [][]rune{")
+	for _, class := range classes {
+		quoted := make([]string, 0, len(class))
+		for _, r := range class {
+			quoted = append(quoted, strconv.QuoteRune(r))
+		}
+		fmt.Fprintf(&b, "\t{%s},\n", strings.Join(quoted, ", "))
+	}
+	fmt.Fprintln(&b, "}")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,60 @@
+package alphabet
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// zeroWidthJoiner joins emoji to a sequence, which is displayed as one character, like "👩‍💻".
+const zeroWidthJoiner = '‍'
+
+// Graphemes splits s into grapheme clusters, which users perceive as one character each.
+//
+// It approximates the extended grapheme clusters of Unicode Standard Annex #29: a cluster is a character followed
+// by its combining marks, variation selectors and emoji modifiers. Characters joined by a zero width joiner, a pair of
+// regional indicators forming a flag and "\r\n" are one cluster too.
+func Graphemes(s string) []string {
+	var clusters []string
+	for len(s) > 0 {
+		n := graphemeLen(s)
+		clusters = append(clusters, s[:n])
+		s = s[n:]
+	}
+	return clusters
+}
+
+// graphemeLen returns the length in bytes of the first grapheme cluster of s.
+func graphemeLen(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+	if r == '\r' && len(s) > n && s[n] == '\n' {
+		return n + 1
+	}
+
+	prev := r
+	flag := isRegionalIndicator(r)
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		switch {
+		case isGraphemeExtend(r), prev == zeroWidthJoiner:
+		case flag && isRegionalIndicator(r):
+			flag = false
+		default:
+			return n
+		}
+		prev = r
+		n += size
+	}
+	return n
+}
+
+// isGraphemeExtend reports whether r extends the grapheme cluster before it.
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Variation_Selector) ||
+		r == zeroWidthJoiner ||
+		r >= 0x1f3fb && r <= 0x1f3ff || // emoji modifiers for the skin tone
+		r >= 0xe0020 && r <= 0xe007f // tags of emoji flags for subdivisions
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
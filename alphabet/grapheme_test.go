@@ -0,0 +1,29 @@
+package alphabet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"abc", []string{"a", "b", "c"}},
+		{"e\u0301a", []string{"e\u0301", "a"}},
+		{"👩‍💻!", []string{"👩‍💻", "!"}},
+		{"👍🏽", []string{"👍🏽"}},
+		{"🇩🇪🇦🇹", []string{"🇩🇪", "🇦🇹"}},
+		{"\r\nx", []string{"\r\n", "x"}},
+		{"नमस्ते", []string{"न", "म", "स्", "ते"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := Graphemes(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graphemes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package alphabet
+
+import (
+	"fmt"
+	"golang.org/x/text/unicode/runenames"
+	"golang.org/x/text/width"
+	"unicode"
+)
+
+//go:generate go run gen_confusables.go
+
+// scripts, which contain confusable letters, by their ISO 15924 code.
+var scripts = map[string]*unicode.RangeTable{
+	"Latn": unicode.Latin,
+	"Cyrl": unicode.Cyrillic,
+	"Grek": unicode.Greek,
+	"Armn": unicode.Armenian,
+}
+
+// scriptNames are the English names of scripts.
+var scriptNames = map[*unicode.RangeTable]string{
+	unicode.Latin:    "Latin",
+	unicode.Cyrillic: "Cyrillic",
+	unicode.Greek:    "Greek",
+	unicode.Armenian: "Armenian",
+}
+
+// Homoglyph is a letter of another script than expected, which may look like a letter of the expected script.
+type Homoglyph struct {
+	// Pos is the position of the letter in the text, counting grapheme clusters from 1.
+	Pos int
+	// Char is the letter.
+	Char rune
+	// Script is the name of the script of Char, like "Cyrillic".
+	Script string
+	// Expected is the name of the expected script, like "Latin".
+	Expected string
+	// LooksLike is the letter of the expected script, which looks like Char. It is 0, if there is none.
+	LooksLike rune
+}
+
+// Name returns the Unicode name of the letter, like "CYRILLIC SMALL LETTER A".
+func (h Homoglyph) Name() string {
+	return runenames.Name(h.Char)
+}
+
+// String describes h, like "CYRILLIC SMALL LETTER A (looks like Latin a)".
+func (h Homoglyph) String() string {
+	if h.LooksLike == 0 {
+		return fmt.Sprintf("%s (%s in %s text)", h.Name(), h.Script, h.Expected)
+	}
+	return fmt.Sprintf("%s (looks like %s %c)", h.Name(), h.Expected, h.LooksLike)
+}
+
+// Homoglyphs finds letters in text, which are of another script than their neighbours or than sa.
+//
+// The expected script of a letter is the script of most letters in its word. If the word has no majority,
+// the script of sa is expected. Words of another script than sa are reported too, if all their letters look like
+// letters of sa, like the Cyrillic "аре" in text spelled with a Latin alphabet. Letters of the script of sa, which sa
+// does not spell, are reported, if they look like a letter it spells, like the Latin "ɑ" or the fullwidth "ａ" for "a".
+//
+// Only the Latin, Cyrillic, Greek and Armenian scripts are checked. Which letters look alike is known from the
+// confusables data of Unicode Technical Standard #39 and the fullwidth forms of letters.
+func (sa SpellingAlphabet) Homoglyphs(text string) []Homoglyph {
+	own := sa.script()
+
+	var homoglyphs []Homoglyph
+	clusters := Graphemes(text)
+	for start := 0; start < len(clusters); {
+		end := start
+		count := make(map[*unicode.RangeTable]int)
+		for ; end < len(clusters) && isWordCluster(clusters[end]); end++ {
+			if script := scriptOf([]rune(clusters[end])[0]); script != nil {
+				count[script]++
+			}
+		}
+		if end == start {
+			start++
+			continue
+		}
+
+		expected := majority(count, own)
+		disguised := own != nil && expected != own && isWholeScriptConfusable(clusters[start:end], own)
+		for i := start; i < end; i++ {
+			r := []rune(clusters[i])[0]
+			script := scriptOf(r)
+			if script == nil {
+				continue
+			}
+			if script != expected {
+				homoglyphs = append(homoglyphs, Homoglyph{i + 1, r, scriptNames[script], scriptNames[expected], looksLike(r, expected)})
+			} else if disguised {
+				homoglyphs = append(homoglyphs, Homoglyph{i + 1, r, scriptNames[script], scriptNames[own], looksLike(r, own)})
+			} else if c := looksLike(r, own); script == own && !sa.spells(r) && sa.spells(c) {
+				homoglyphs = append(homoglyphs, Homoglyph{i + 1, r, scriptNames[script], scriptNames[own], c})
+			}
+		}
+		start = end
+	}
+	return homoglyphs
+}
+
+// script returns the script of the letters of sa or nil, if it has no confusable letters.
+func (sa SpellingAlphabet) script() *unicode.RangeTable {
+	script, _ := sa.lang.Script()
+	return scripts[script.String()]
+}
+
+// spells reports whether sa has a phonetic form for the letter r.
+func (sa SpellingAlphabet) spells(r rune) bool {
+	_, ok := sa.m[sa.lower(string(r))]
+	return ok
+}
+
+// scriptOf returns the script of r, if it is one with confusable letters.
+func scriptOf(r rune) *unicode.RangeTable {
+	if !unicode.IsLetter(r) {
+		return nil
+	}
+	for table := range scriptNames {
+		if unicode.Is(table, r) {
+			return table
+		}
+	}
+	return nil
+}
+
+// majority returns the script with the highest count. On a tie, it prefers fallback.
+func majority(count map[*unicode.RangeTable]int, fallback *unicode.RangeTable) *unicode.RangeTable {
+	best := fallback
+	for script, n := range count {
+		if n > count[best] || n == count[best] && best != fallback && scriptNames[script] < scriptNames[best] {
+			best = script
+		}
+	}
+	return best
+}
+
+// looksLike returns the letter of script, which looks like r, or 0 if there is none. It prefers a letter of the same
+// case as r.
+func looksLike(r rune, script *unicode.RangeTable) rune {
+	if narrow := width.LookupRune(r).Narrow(); narrow != 0 && unicode.Is(script, narrow) {
+		return narrow
+	}
+	var found rune
+	for _, class := range confusables {
+		if !containsRune(class, r) {
+			continue
+		}
+		for _, c := range class {
+			if c == r || !unicode.Is(script, c) {
+				continue
+			}
+			if unicode.IsUpper(c) == unicode.IsUpper(r) {
+				return c
+			}
+			if found == 0 {
+				found = c
+			}
+		}
+	}
+	return found
+}
+
+func containsRune(runes []rune, r rune) bool {
+	for _, c := range runes {
+		if c == r {
+			return true
+		}
+	}
+	return false
+}
+
+// isWholeScriptConfusable reports whether all letters of word look like letters of script.
+func isWholeScriptConfusable(word []string, script *unicode.RangeTable) bool {
+	for _, cluster := range word {
+		r := []rune(cluster)[0]
+		if unicode.IsLetter(r) && scriptOf(r) != script && looksLike(r, script) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordCluster(cluster string) bool {
+	r := []rune(cluster)[0]
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
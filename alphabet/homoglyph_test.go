@@ -0,0 +1,59 @@
+package alphabet
+
+import (
+	"testing"
+)
+
+func TestHomoglyphs(t *testing.T) {
+	tests := []struct {
+		alphabet SpellingAlphabet
+		text     string
+		want     []string
+		wantPos  []int
+	}{
+		{English, "pаypal.com", []string{"CYRILLIC SMALL LETTER A (looks like Latin a)"}, []int{2}},
+		{English, "gοοgle", []string{"GREEK SMALL LETTER OMICRON (looks like Latin o)", "GREEK SMALL LETTER OMICRON (looks like Latin o)"}, []int{2, 3}},
+		{English, "аре", []string{"CYRILLIC SMALL LETTER A (looks like Latin a)", "CYRILLIC SMALL LETTER ER (looks like Latin p)", "CYRILLIC SMALL LETTER IE (looks like Latin e)"}, []int{1, 2, 3}},
+		{English, "2ех", []string{"CYRILLIC SMALL LETTER IE (looks like Latin e)", "CYRILLIC SMALL LETTER HA (looks like Latin x)"}, []int{2, 3}},
+		{English, "xλy", []string{"GREEK SMALL LETTER LAMDA (Greek in Latin text)"}, []int{2}},
+		{Russian, "мaма", []string{"LATIN SMALL LETTER A (looks like Cyrillic а)"}, []int{2}},
+		{Russian, "мама", nil, nil},
+		{English, "ԍoogle", []string{"CYRILLIC SMALL LETTER KOMI SJE (looks like Latin g)"}, []int{1}},
+		{English, "gօօgle", []string{"ARMENIAN SMALL LETTER OH (looks like Latin o)", "ARMENIAN SMALL LETTER OH (looks like Latin o)"}, []int{2, 3}},
+		{English, "Іnfo", []string{"CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I (looks like Latin I)"}, []int{1}},
+		{English, "pɑypal", []string{"LATIN SMALL LETTER ALPHA (looks like Latin a)"}, []int{2}},
+		{English, "ｐaypal", []string{"FULLWIDTH LATIN SMALL LETTER P (looks like Latin p)"}, []int{1}},
+		{Turkish, "ılık", nil, nil},
+		{German, "Straße", nil, nil},
+		{English, "paypal.com мир", nil, nil},
+		{English, "1 + 1", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got := tt.alphabet.Homoglyphs(tt.text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Homoglyphs() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i].String() != tt.want[i] || got[i].Pos != tt.wantPos[i] {
+					t.Errorf("Homoglyphs()[%d] = %d %v, want %d %v", i, got[i].Pos, got[i], tt.wantPos[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestConfusables(t *testing.T) {
+	seen := make(map[rune]bool)
+	for _, class := range confusables {
+		for _, r := range class {
+			if seen[r] {
+				t.Errorf("%q is in more than one class", r)
+			}
+			if scriptOf(r) == nil {
+				t.Errorf("%q is no letter of a checked script", r)
+			}
+			seen[r] = true
+		}
+	}
+}
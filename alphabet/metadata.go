@@ -0,0 +1,50 @@
+package alphabet
+
+import (
+	"strings"
+)
+
+// Validity tells whether a SpellingAlphabet is defined by a standard, which is still in force.
+type Validity int
+
+const (
+	Informal   Validity = iota // used by convention, but not defined by a standard
+	Current                    // defined by a standard in force
+	Superseded                 // defined by a standard, which was replaced or withdrawn
+)
+
+var validityNames = []string{"Informal", "Current", "Superseded"}
+
+func (v Validity) String() string {
+	return validityNames[v]
+}
+
+// MarshalText encodes v in lower case, like "current".
+func (v Validity) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(v.String())), nil
+}
+
+// Metadata describes where a SpellingAlphabet is defined and how it is used.
+type Metadata struct {
+	// Standard defining the SpellingAlphabet, like "DIN 5009". It is empty for informal alphabets.
+	Standard string `json:"standard,omitempty"`
+	// Version of the Standard, usually the year of its publication, like "2022".
+	Version string `json:"version,omitempty"`
+	// Source is a URL or a citation of the definition.
+	Source string `json:"source,omitempty"`
+	// Status tells whether the Standard is in force.
+	Status Validity `json:"status"`
+	// Connector joins a character and its word, like "as in" of "A as in Alfa". It is empty if it is unknown.
+	Connector string `json:"connector,omitempty"`
+	// Notes on the origin, era and use of the SpellingAlphabet.
+	Notes string `json:"notes,omitempty"`
+}
+
+// Reference names the Standard together with its Version, like "DIN 5009 (2022)". It is empty for informal
+// alphabets.
+func (m Metadata) Reference() string {
+	if m.Version == "" {
+		return m.Standard
+	}
+	return m.Standard + " (" + m.Version + ")"
+}
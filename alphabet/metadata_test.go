@@ -0,0 +1,35 @@
+package alphabet
+
+import (
+	"testing"
+)
+
+func TestMetadata_Reference(t *testing.T) {
+	tests := []struct {
+		alphabet SpellingAlphabet
+		want     string
+	}{
+		{CityGerman, "DIN 5009 (2022)"},
+		{AustrianGerman, "ÖNORM A 1081"},
+		{Italian, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alphabet.LangTag(), func(t *testing.T) {
+			if got := tt.alphabet.Metadata().Reference(); got != tt.want {
+				t.Errorf("Reference() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetadata_Status(t *testing.T) {
+	for _, a := range All {
+		m := a.Metadata()
+		if (m.Standard == "") != (m.Status == Informal) {
+			t.Errorf("%v has standard %q with status %v, only informal alphabets have no standard", a.LangTag(), m.Standard, m.Status)
+		}
+	}
+	if text, _ := Superseded.MarshalText(); string(text) != "superseded" {
+		t.Errorf("MarshalText() = %s, want superseded", text)
+	}
+}
@@ -0,0 +1,349 @@
+package alphabet
+
+import (
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// AllMorse are the Morse code variants.
+//
+// Morse code variants are SpellingAlphabets, which spell keys in dots and dashes.
+// Their phonetic form separates the signs of a character by nothing, characters by a space and words by " / ".
+// Every variant contains the International Morse code. The non-Latin variants add the signs of their script.
+var AllMorse = []SpellingAlphabet{
+	Morse,
+	MorseCyrillic,
+	MorseGreek,
+	MorseWabun,
+}
+
+// LookupMorse returns the Morse code variant for the script of lang together with a confidence score.
+//
+// LookupMorse interprets lang as a BCP 47 language tag.
+// Cyrillic, Greek and Japanese scripts select their variant and Latin script the International Morse code.
+// For all other scripts and if lang is no valid language tag, the International Morse code is used as default.
+func LookupMorse(lang string) (SpellingAlphabet, Exactness) {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return Morse, Default
+	}
+
+	script, c := tag.Script()
+	exactness := Exact
+	if c == language.Low {
+		exactness = Guess
+	}
+	switch script.String() {
+	case "Latn":
+		return Morse, exactness
+	case "Cyrl":
+		return MorseCyrillic, exactness
+	case "Grek":
+		return MorseGreek, exactness
+	case "Jpan", "Hira", "Kana", "Hrkt":
+		return MorseWabun, exactness
+	}
+	return Morse, Default
+}
+
+// DitDah rewrites Morse code spelled by a Morse code variant as spoken "dit" and "dah" words.
+//
+// The signs of one character are joined by a hyphen, e.g. ".-" is written as "dit-dah".
+// Everything which is no Morse code, like word separators and unmapped characters, stays untouched.
+func DitDah(code string) string {
+	fields := strings.Split(code, " ")
+	for i, field := range fields {
+		if !isMorseCode(field) {
+			continue
+		}
+		signs := make([]string, 0, len(field))
+		for _, sign := range field {
+			if sign == '.' {
+				signs = append(signs, "dit")
+			} else {
+				signs = append(signs, "dah")
+			}
+		}
+		fields[i] = strings.Join(signs, "-")
+	}
+	return strings.Join(fields, " ")
+}
+
+func isMorseCode(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r != '.' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// morseITU maps the characters and prosigns of ITU-R M.1677-1 to their Morse code.
+//
+// Prosigns are written in angle brackets, e.g. "<AR>".
+var morseITU = map[string]string{
+	"a":     ".-",
+	"b":     "-...",
+	"c":     "-.-.",
+	"d":     "-..",
+	"e":     ".",
+	"é":     "..-..",
+	"f":     "..-.",
+	"g":     "--.",
+	"h":     "....",
+	"i":     "..",
+	"j":     ".---",
+	"k":     "-.-",
+	"l":     ".-..",
+	"m":     "--",
+	"n":     "-.",
+	"o":     "---",
+	"p":     ".--.",
+	"q":     "--.-",
+	"r":     ".-.",
+	"s":     "...",
+	"t":     "-",
+	"u":     "..-",
+	"v":     "...-",
+	"w":     ".--",
+	"x":     "-..-",
+	"y":     "-.--",
+	"z":     "--..",
+	"0":     "-----",
+	"1":     ".----",
+	"2":     "..---",
+	"3":     "...--",
+	"4":     "....-",
+	"5":     ".....",
+	"6":     "-....",
+	"7":     "--...",
+	"8":     "---..",
+	"9":     "----.",
+	" ":     "/",
+	".":     ".-.-.-",
+	",":     "--..--",
+	":":     "---...",
+	"?":     "..--..",
+	"'":     ".----.",
+	"-":     "-....-",
+	"/":     "-..-.",
+	"(":     "-.--.",
+	")":     "-.--.-",
+	"\"":    ".-..-.",
+	"=":     "-...-",
+	"+":     ".-.-.",
+	"×":     "-..-",
+	"@":     ".--.-.",
+	"<ar>":  ".-.-.",
+	"<as>":  ".-...",
+	"<bt>":  "-...-",
+	"<ct>":  "-.-.-",
+	"<hh>":  "........",
+	"<kn>":  "-.--.",
+	"<sk>":  "...-.-",
+	"<sn>":  "...-.",
+	"<sos>": "...---...",
+}
+
+// withMorseITU returns a new map containing morseITU and m.
+func withMorseITU(m map[string]string) map[string]string {
+	r := make(map[string]string, len(morseITU)+len(m))
+	for k, v := range morseITU {
+		r[k] = v
+	}
+	for k, v := range m {
+		r[k] = v
+	}
+	return r
+}
+
+var (
+	Morse = SpellingAlphabet{
+		lang:  language.English,
+		names: []string{"ITU-R M.1677-1"},
+		m:     withMorseITU(nil),
+	}
+	MorseCyrillic = SpellingAlphabet{
+		lang:  language.Russian,
+		names: []string{"Russian Morse code"},
+		m: withMorseITU(map[string]string{
+			"а": ".-",
+			"б": "-...",
+			"в": ".--",
+			"г": "--.",
+			"д": "-..",
+			"е": ".",
+			"ё": ".",
+			"ж": "...-",
+			"з": "--..",
+			"и": "..",
+			"й": ".---",
+			"к": "-.-",
+			"л": ".-..",
+			"м": "--",
+			"н": "-.",
+			"о": "---",
+			"п": ".--.",
+			"р": ".-.",
+			"с": "...",
+			"т": "-",
+			"у": "..-",
+			"ф": "..-.",
+			"х": "....",
+			"ц": "-.-.",
+			"ч": "---.",
+			"ш": "----",
+			"щ": "--.-",
+			"ъ": "--.--",
+			"ы": "-.--",
+			"ь": "-..-",
+			"э": "..-..",
+			"ю": "..--",
+			"я": ".-.-",
+		}),
+	}
+	MorseGreek = SpellingAlphabet{
+		lang:  language.Greek,
+		names: []string{"Greek Morse code"},
+		m: withMorseITU(map[string]string{
+			"α": ".-",
+			"ά": ".-",
+			"β": "-...",
+			"γ": "--.",
+			"δ": "-..",
+			"ε": ".",
+			"έ": ".",
+			"ζ": "--..",
+			"η": "....",
+			"ή": "....",
+			"θ": "-.-.",
+			"ι": "..",
+			"ί": "..",
+			"ϊ": "..",
+			"κ": "-.-",
+			"λ": ".-..",
+			"μ": "--",
+			"ν": "-.",
+			"ξ": "-..-",
+			"ο": "---",
+			"ό": "---",
+			"π": ".--.",
+			"ρ": ".-.",
+			"σ": "...",
+			"ς": "...",
+			"τ": "-",
+			"υ": "-.--",
+			"ύ": "-.--",
+			"ϋ": "-.--",
+			"φ": "..-.",
+			"χ": "----",
+			"ψ": "--.-",
+			"ω": ".--",
+			"ώ": ".--",
+		}),
+	}
+	MorseWabun = SpellingAlphabet{
+		lang:  language.Japanese,
+		names: []string{"Wabun code"},
+		m:     withMorseITU(wabun()),
+	}
+)
+
+// wabun returns the Wabun code for katakana and hiragana.
+//
+// Voiced and semi-voiced kana are sent as their base kana followed by the sign for dakuten or handakuten.
+func wabun() map[string]string {
+	katakana := map[rune]string{
+		'ア': "--.--",
+		'イ': ".-",
+		'ウ': "..-",
+		'エ': "-.---",
+		'オ': ".-...",
+		'カ': ".-..",
+		'キ': "-.-..",
+		'ク': "...-",
+		'ケ': "-.--",
+		'コ': "----",
+		'サ': "-.-.-",
+		'シ': "--.-.",
+		'ス': "---.-",
+		'セ': ".---.",
+		'ソ': "---.",
+		'タ': "-.",
+		'チ': "..-.",
+		'ツ': ".--.",
+		'テ': ".-.--",
+		'ト': "..-..",
+		'ナ': ".-.",
+		'ニ': "-.-.",
+		'ヌ': "....",
+		'ネ': "--.-",
+		'ノ': "..--",
+		'ハ': "-...",
+		'ヒ': "--..-",
+		'フ': "--..",
+		'ヘ': ".",
+		'ホ': "-..",
+		'マ': "-..-",
+		'ミ': "..-.-",
+		'ム': "-",
+		'メ': "-...-",
+		'モ': "-..-.",
+		'ヤ': ".--",
+		'ユ': "-..--",
+		'ヨ': "--",
+		'ラ': "...",
+		'リ': "--.",
+		'ル': "-.--.",
+		'レ': "---",
+		'ロ': ".-.-",
+		'ワ': "-.-",
+		'ヰ': ".-..-",
+		'ヱ': ".--..",
+		'ヲ': ".---",
+		'ン': ".-.-.",
+	}
+	const (
+		dakuten    = ".."
+		handakuten = "..--."
+		// hiraganaOffset is the distance between a hiragana and its katakana code point.
+		hiraganaOffset = 'ア' - 'あ'
+	)
+
+	m := make(map[string]string, 4*len(katakana))
+	for kana, code := range katakana {
+		m[string(kana)] = code
+		// Voiced kana follow their base kana in the Unicode block.
+		if strings.ContainsRune("カキクケコサシスセソタチツテトハヒフヘホ", kana) {
+			m[string(kana+1)] = code + " " + dakuten
+		}
+		if strings.ContainsRune("ハヒフヘホ", kana) {
+			m[string(kana+2)] = code + " " + handakuten
+		}
+	}
+	m["ヴ"] = katakana['ウ'] + " " + dakuten
+
+	for k, v := range katakanaOnly(m) {
+		m[string([]rune(k)[0]-hiraganaOffset)] = v
+	}
+	m["ー"] = ".--.-"
+	m["、"] = ".-.-.-"
+	m["。"] = ".-.-.."
+	m["゛"] = dakuten
+	m["゜"] = handakuten
+	return m
+}
+
+// katakanaOnly returns a copy of m, containing only the keys of m consisting of a katakana with a hiragana counterpart.
+func katakanaOnly(m map[string]string) map[string]string {
+	r := make(map[string]string, len(m))
+	for k, v := range m {
+		if kana := []rune(k); len(kana) == 1 && 'ァ' <= kana[0] && kana[0] <= 'ヶ' {
+			r[k] = v
+		}
+	}
+	return r
+}
@@ -0,0 +1,73 @@
+package alphabet
+
+import (
+	"testing"
+)
+
+func TestSpell_Morse(t *testing.T) {
+	for _, a := range AllMorse {
+		t.Run(a.lang.String(), func(t *testing.T) {
+			testSpellAlphabet(t, a)
+		})
+	}
+}
+
+func TestSpell_MorseText(t *testing.T) {
+	testSpell(t, Morse, "SOS", "... --- ...")
+	testSpell(t, Morse, "Hi 5", ".... .. / .....")
+	testSpell(t, Morse, "<AR>", ".-.-.")
+	testSpell(t, Morse, "<Ar> <", ".-.-. / '<'")
+	testSpell(t, MorseCyrillic, "Мир", "-- .. .-.")
+	testSpell(t, MorseGreek, "Ψάρι", "--.- .- .-. ..")
+	testSpell(t, MorseWabun, "ガ", ".-.. ..")
+	testSpell(t, MorseWabun, "ぱ", "-... ..--.")
+}
+
+func TestLookupMorse(t *testing.T) {
+	tests := []struct {
+		lang      string
+		want      SpellingAlphabet
+		wantExact Exactness
+	}{
+		{"en", Morse, Exact},
+		{"de-AT", Morse, Exact},
+		{"ru", MorseCyrillic, Exact},
+		{"uk", MorseCyrillic, Exact},
+		{"el", MorseGreek, Exact},
+		{"ja", MorseWabun, Exact},
+		{"sr-Cyrl", MorseCyrillic, Exact},
+		{"zh", Morse, Default},
+		{"default", Morse, Default},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			got, exactness := LookupMorse(tt.lang)
+			if got.lang != tt.want.lang {
+				t.Errorf("LookupMorse() = %v, want %v", got.lang, tt.want.lang)
+			}
+			if exactness != tt.wantExact {
+				t.Errorf("LookupMorse() exactness = %v, want %v", exactness, tt.wantExact)
+			}
+		})
+	}
+}
+
+func TestDitDah(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{".-", "dit-dah"},
+		{"... --- ...", "dit-dit-dit dah-dah-dah dit-dit-dit"},
+		{".. / -", "dit-dit / dah"},
+		{".- '€'", "dit-dah '€'"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := DitDah(tt.code); got != tt.want {
+				t.Errorf("DitDah() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package alphabet
+
+import (
+	"strings"
+)
+
+// phoneticCode encodes a word by its pronunciation, so similar sounding words get similar codes.
+// The word consists of lower case ASCII letters only.
+type phoneticCode func(word string) string
+
+// metaphone returns the Metaphone code of word, which encodes English pronunciation.
+func metaphone(word string) string {
+	w := strings.ToUpper(word)
+	switch {
+	case w == "":
+		return ""
+	case strings.HasPrefix(w, "AE"), strings.HasPrefix(w, "GN"), strings.HasPrefix(w, "KN"),
+		strings.HasPrefix(w, "PN"), strings.HasPrefix(w, "WR"):
+		w = w[1:]
+	case w[0] == 'X':
+		w = "S" + w[1:]
+	case strings.HasPrefix(w, "WH"):
+		w = "W" + w[2:]
+	}
+
+	at := func(i int) byte {
+		if i < 0 || i >= len(w) {
+			return 0
+		}
+		return w[i]
+	}
+	isVowel := func(c byte) bool {
+		return c != 0 && strings.IndexByte("AEIOU", c) >= 0
+	}
+	isFrontVowel := func(c byte) bool {
+		return c == 'E' || c == 'I' || c == 'Y'
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(w); i++ {
+		c := at(i)
+		if c == at(i-1) && c != 'C' {
+			continue
+		}
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if i == 0 {
+				sb.WriteByte(c)
+			}
+		case 'B':
+			if !(at(i-1) == 'M' && i == len(w)-1) {
+				sb.WriteByte('B')
+			}
+		case 'C':
+			switch {
+			case at(i+1) == 'I' && at(i+2) == 'A':
+				sb.WriteByte('X')
+			case at(i+1) == 'H':
+				if at(i-1) == 'S' {
+					sb.WriteByte('K')
+				} else {
+					sb.WriteByte('X')
+				}
+				i++
+			case isFrontVowel(at(i + 1)):
+				if at(i-1) != 'S' {
+					sb.WriteByte('S')
+				}
+			default:
+				sb.WriteByte('K')
+			}
+		case 'D':
+			if at(i+1) == 'G' && isFrontVowel(at(i+2)) {
+				sb.WriteByte('J')
+				i++
+			} else {
+				sb.WriteByte('T')
+			}
+		case 'G':
+			switch {
+			case at(i+1) == 'H' && i+2 < len(w) && !isVowel(at(i+2)):
+			case at(i+1) == 'N' && (i+2 == len(w) || w[i+1:] == "NED"):
+			case isFrontVowel(at(i+1)) && at(i-1) != 'G':
+				sb.WriteByte('J')
+			default:
+				sb.WriteByte('K')
+			}
+		case 'H':
+			if isVowel(at(i+1)) && strings.IndexByte("CSPTG", at(i-1)) < 0 {
+				sb.WriteByte('H')
+			}
+		case 'K':
+			if at(i-1) != 'C' {
+				sb.WriteByte('K')
+			}
+		case 'P':
+			if at(i+1) == 'H' {
+				sb.WriteByte('F')
+			} else {
+				sb.WriteByte('P')
+			}
+		case 'Q':
+			sb.WriteByte('K')
+		case 'S':
+			switch {
+			case at(i+1) == 'H':
+				sb.WriteByte('X')
+				i++
+			case at(i+1) == 'I' && (at(i+2) == 'O' || at(i+2) == 'A'):
+				sb.WriteByte('X')
+			default:
+				sb.WriteByte('S')
+			}
+		case 'T':
+			switch {
+			case at(i+1) == 'I' && (at(i+2) == 'O' || at(i+2) == 'A'):
+				sb.WriteByte('X')
+			case at(i+1) == 'H':
+				sb.WriteByte('0')
+				i++
+			case at(i+1) == 'C' && at(i+2) == 'H':
+			default:
+				sb.WriteByte('T')
+			}
+		case 'V':
+			sb.WriteByte('F')
+		case 'W', 'Y':
+			if isVowel(at(i + 1)) {
+				sb.WriteByte(c)
+			}
+		case 'X':
+			sb.WriteString("KS")
+		case 'Z':
+			sb.WriteByte('S')
+		case 'F', 'J', 'L', 'M', 'N', 'R':
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// cologne returns the Cologne phonetics code of word, which encodes German pronunciation.
+func cologne(word string) string {
+	w := strings.ToUpper(word)
+	at := func(i int) byte {
+		if i < 0 || i >= len(w) {
+			return 0
+		}
+		return w[i]
+	}
+	in := func(c byte, set string) bool {
+		return c != 0 && strings.IndexByte(set, c) >= 0
+	}
+
+	var digits []byte
+	for i := 0; i < len(w); i++ {
+		c := at(i)
+		var d string
+		switch {
+		case in(c, "AEIJOUY"):
+			d = "0"
+		case c == 'B':
+			d = "1"
+		case c == 'P':
+			if at(i+1) == 'H' {
+				d = "3"
+			} else {
+				d = "1"
+			}
+		case in(c, "DT"):
+			if in(at(i+1), "CSZ") {
+				d = "8"
+			} else {
+				d = "2"
+			}
+		case in(c, "FVW"):
+			d = "3"
+		case in(c, "GKQ"):
+			d = "4"
+		case c == 'C':
+			if i == 0 && in(at(i+1), "AHKLOQRUX") || i > 0 && in(at(i+1), "AHKOQUX") && !in(at(i-1), "SZ") {
+				d = "4"
+			} else {
+				d = "8"
+			}
+		case c == 'X':
+			if in(at(i-1), "CKQ") {
+				d = "8"
+			} else {
+				d = "48"
+			}
+		case c == 'L':
+			d = "5"
+		case in(c, "MN"):
+			d = "6"
+		case c == 'R':
+			d = "7"
+		case in(c, "SZ"):
+			d = "8"
+		}
+		digits = append(digits, d...)
+	}
+
+	var sb strings.Builder
+	for i, d := range digits {
+		if i > 0 && (d == digits[i-1] || d == '0') {
+			continue
+		}
+		sb.WriteByte(d)
+	}
+	return sb.String()
+}
@@ -0,0 +1,54 @@
+package alphabet
+
+import (
+	"testing"
+)
+
+func TestMetaphone(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"alfa", "ALF"},
+		{"alpha", "ALF"},
+		{"knight", "NT"},
+		{"juliett", "JLT"},
+		{"whiskey", "WSK"},
+		{"xray", "SR"},
+		{"charlie", "XRL"},
+		{"school", "SKL"},
+		{"quebec", "KBK"},
+		{"thumb", "0M"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := metaphone(tt.word); got != tt.want {
+				t.Errorf("metaphone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCologne(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"wikipedia", "3412"},
+		{"mullerludenscheidt", "65752682"},
+		{"meier", "67"},
+		{"mayr", "67"},
+		{"cesar", "887"},
+		{"casar", "487"},
+		{"xaver", "4837"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := cologne(tt.word); got != tt.want {
+				t.Errorf("cologne() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package chunk
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// Email is an email address, like "john.doe@example.co.uk".
+//
+// It is split into its segments, like "john" and "example", and the separators between them, like "." and "@".
+var Email = Format{
+	Name:        "email",
+	Description: "email address",
+	normalize:   strings.TrimSpace,
+	validate:    validateEmail,
+	split:       segments,
+	wordsAt:     emailWords,
+}
+
+// URL is a web address, like "https://example.com/path?query=1".
+//
+// It is split into the segments of its scheme, host, path and query, like "https" and "example",
+// and the separators between them, like "://" and ".".
+var URL = Format{
+	Name:        "url",
+	Description: "URL",
+	normalize:   strings.TrimSpace,
+	validate: func(value string) error {
+		u, err := url.Parse(value)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" && u.Opaque == "" {
+			return fmt.Errorf("it has no scheme or host")
+		}
+		return nil
+	},
+	split:   segments,
+	wordsAt: urlWords,
+}
+
+func validateEmail(email string) error {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return fmt.Errorf("it has no local part and domain separated by '@'")
+	}
+	for _, label := range strings.Split(email[at+1:], ".") {
+		if label == "" {
+			return fmt.Errorf("the domain has an empty label")
+		}
+	}
+	if strings.ContainsAny(email, " \t\n") {
+		return fmt.Errorf("it contains spaces")
+	}
+	return nil
+}
+
+// segments splits s into runs of letters and digits and runs of other characters, which separate them.
+func segments(s string) []string {
+	var parts []string
+	var part strings.Builder
+	var segment bool
+	for _, r := range s {
+		isSegment := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if part.Len() > 0 && isSegment != segment {
+			parts = append(parts, part.String())
+			part.Reset()
+		}
+		segment = isSegment
+		part.WriteRune(r)
+	}
+	if part.Len() > 0 {
+		parts = append(parts, part.String())
+	}
+	return parts
+}
+
+// wellKnown are parts of email addresses and URLs, which are usually said as a word instead of being spelled.
+var wellKnown = map[string]bool{
+	"ftp": true, "http": true, "https": true, "mailto": true, "www": true,
+	"com": true, "edu": true, "gov": true, "info": true, "net": true, "org": true,
+	"at": true, "ch": true, "co": true, "de": true, "eu": true, "fr": true, "io": true, "nl": true, "uk": true,
+}
+
+// isWellKnown reports whether part of an email address or URL, like "com" or "https", is usually said as a word.
+func isWellKnown(part string) bool {
+	return wellKnown[strings.ToLower(part)]
+}
+
+// emailWords returns the index of the top-level domain in the segments of an email address, like "com" of
+// "john@example.com". The local part and the other labels of the domain are always spelled.
+func emailWords(parts []string) []int {
+	at := indexOf(parts, 0, "@")
+	if at < 0 || len(parts)-1 <= at+1 {
+		return nil
+	}
+	return []int{len(parts) - 1}
+}
+
+// urlWords returns the indexes of the scheme, a leading "www" label and the top-level domain in the segments of a URL,
+// like "https", "www" and "com" of "https://www.example.com/com". The path, the query and all other labels are always
+// spelled.
+func urlWords(parts []string) []int {
+	if len(parts) < 2 || !strings.HasPrefix(parts[1], ":") {
+		return nil
+	}
+	words := []int{0}
+
+	start := 2
+	end := indexOf(parts, start, "/", "?", "#")
+	if end < 0 {
+		end = len(parts)
+	}
+	if at := indexOf(parts[:end], start, "@"); at >= 0 {
+		start = at + 1
+	}
+	if port := indexOf(parts[:end], start, ":"); port >= 0 {
+		end = port
+	}
+	if end-start < 3 {
+		return words
+	}
+	if strings.EqualFold(parts[start], "www") {
+		words = append(words, start)
+	}
+	return append(words, end-1)
+}
+
+// indexOf returns the index of the first of parts from start on, which contains one of seps, or -1.
+func indexOf(parts []string, start int, seps ...string) int {
+	for i := start; i < len(parts); i++ {
+		for _, sep := range seps {
+			if strings.Contains(parts[i], sep) {
+				return i
+			}
+		}
+	}
+	return -1
+}
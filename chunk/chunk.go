@@ -0,0 +1,109 @@
+// Package chunk validates and normalizes formatted values, like IBANs or email addresses, and splits them into their
+// standard groups.
+// Clients should not use this internal package, used by github.com/simonnagl/spell/cmd/spell.
+package chunk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format is a kind of value, which is spelled in groups.
+type Format struct {
+	// Name of the Format, like "iban".
+	Name string
+	// Description of the Format for users.
+	Description string
+	// normalize removes separators and fixes the case of a value.
+	normalize func(value string) string
+	// validate returns an error, if a normalized value is invalid.
+	validate func(value string) error
+	// split splits a normalized value into groups.
+	split func(value string) []string
+	// wordsAt returns the indexes of the groups, which may be said as words, like the top-level domain of an email
+	// address. Can be nil.
+	wordsAt func(groups []string) []int
+}
+
+// Formats, which Lookup finds by their name.
+var Formats = []Format{IBAN, UUID, Hex, Base32, Key, Email, URL}
+
+// Lookup returns the Format with name.
+func Lookup(name string) (Format, bool) {
+	for _, f := range Formats {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+// Normalize removes separators from value and fixes its case.
+func (f Format) Normalize(value string) string {
+	return f.normalize(value)
+}
+
+// Split normalizes value and splits it into the standard groups of f.
+//
+// Split returns the groups of an invalid value too, together with an error describing the problem.
+func (f Format) Split(value string) ([]string, error) {
+	normalized := f.normalize(value)
+	return f.split(normalized), f.validate(normalized)
+}
+
+// Words reports for each of the groups returned by Split, whether it is usually said as a word instead of being
+// spelled, like "https" or "com" of a URL.
+//
+// Only the scheme of a URL, a leading "www" and the top-level domain are said as words. Other groups are always
+// spelled, even if they look like well-known words, like a mailbox called "at".
+func (f Format) Words(groups []string) []bool {
+	words := make([]bool, len(groups))
+	if f.wordsAt == nil {
+		return words
+	}
+	for _, i := range f.wordsAt(groups) {
+		words[i] = isWellKnown(groups[i])
+	}
+	return words
+}
+
+// strip removes all characters of cutset from s.
+func strip(s string, cutset string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(cutset, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// fixed splits s into groups of n characters. The last group may be shorter.
+func fixed(n int) func(s string) []string {
+	return func(s string) []string {
+		var groups []string
+		runes := []rune(s)
+		for len(runes) > n {
+			groups = append(groups, string(runes[:n]))
+			runes = runes[n:]
+		}
+		if len(runes) > 0 {
+			groups = append(groups, string(runes))
+		}
+		return groups
+	}
+}
+
+// only returns a validation, which accepts only characters of set.
+func only(set string, name string) func(s string) error {
+	return func(s string) error {
+		if s == "" {
+			return fmt.Errorf("it is empty")
+		}
+		for _, r := range s {
+			if !strings.ContainsRune(set, r) {
+				return fmt.Errorf("'%c' is no %s", r, name)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+package chunk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormat_Split(t *testing.T) {
+	tests := []struct {
+		format  Format
+		value   string
+		want    []string
+		wantErr string
+	}{
+		{IBAN, "DE89 3704 0044 0532 0130 00", []string{"DE89", "3704", "0044", "0532", "0130", "00"}, ""},
+		{IBAN, "gb82-west-1234-5698-7654-32", []string{"GB82", "WEST", "1234", "5698", "7654", "32"}, ""},
+		{IBAN, "DE88 3704 0044 0532 0130 00", []string{"DE88", "3704", "0044", "0532", "0130", "00"},
+			"the check digits 88 do not match"},
+		{IBAN, "DE89 3704 0044 0532 0130", []string{"DE89", "3704", "0044", "0532", "0130"},
+			"it has 20 characters instead of 22 for DE"},
+		{UUID, "{123E4567-E89B-12D3-A456-426614174000}", []string{"123e4567", "e89b", "12d3", "a456", "426614174000"}, ""},
+		{UUID, "urn:uuid:123e4567e89b12d3a456426614174000", []string{"123e4567", "e89b", "12d3", "a456", "426614174000"}, ""},
+		{UUID, "123e4567-e89b", []string{"123e4567", "e89b"}, "it has 12 hexadecimal digits instead of 32"},
+		{Hex, "0x43:51:43:A1:B5", []string{"4351", "43a1", "b5"}, ""},
+		{Hex, "43g1", []string{"43g1"}, "'g' is no hexadecimal digit"},
+		{Base32, "jbsw y3dp ehpk 3pxp====", []string{"JBSW", "Y3DP", "EHPK", "3PXP"}, ""},
+		{Base32, "JBSW1", []string{"JBSW", "1"}, "'1' is no Base32 character"},
+		{Key, "abcde-12345-fghij", []string{"ABCDE", "12345", "FGHIJ"}, ""},
+		{Key, "ABCDE12345FG", []string{"ABCDE", "12345", "FG"}, ""},
+		{Email, " john.doe@example.co.uk", []string{"john", ".", "doe", "@", "example", ".", "co", ".", "uk"}, ""},
+		{Email, "john.doe@example.", []string{"john", ".", "doe", "@", "example", "."}, "the domain has an empty label"},
+		{Email, "example.com", []string{"example", ".", "com"}, "it has no local part and domain separated by '@'"},
+		{URL, "https://example.com/a-b?q=1", []string{"https", "://", "example", ".", "com", "/", "a", "-", "b", "?", "q", "=", "1"}, ""},
+		{URL, "example.com", []string{"example", ".", "com"}, "it has no scheme or host"},
+		{Key, "ABC_DE", []string{"ABC_D", "E"}, "'_' is no letter or digit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.Name+" "+tt.value, func(t *testing.T) {
+			got, err := tt.format.Split(tt.value)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split() = %v, want %v", got, tt.want)
+			}
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tt.wantErr {
+				t.Errorf("Split() error = %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, f := range Formats {
+		if got, ok := Lookup(f.Name); !ok || got.Name != f.Name {
+			t.Errorf("Lookup(%v) = %v, %v", f.Name, got.Name, ok)
+		}
+	}
+	if _, ok := Lookup("isbn"); ok {
+		t.Error("Lookup(isbn) found a Format")
+	}
+}
+
+func TestFormat_Words(t *testing.T) {
+	tests := []struct {
+		format Format
+		value  string
+		want   []string
+	}{
+		{Email, "com@de.at", []string{"at"}},
+		{Email, "at@example.com", []string{"com"}},
+		{Email, "john@com", nil},
+		{URL, "https://www.com.de/com?at=1", []string{"https", "www", "de"}},
+		{URL, "http://at@example.org:8080/", []string{"http", "org"}},
+		{URL, "mailto:at@example.com", []string{"mailto", "com"}},
+		{URL, "https://localhost/", []string{"https"}},
+		{IBAN, "DE89 3704 0044 0532 0130 00", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.Name+" "+tt.value, func(t *testing.T) {
+			groups, _ := tt.format.Split(tt.value)
+			var got []string
+			for i, word := range tt.format.Words(groups) {
+				if word {
+					got = append(got, groups[i])
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Words() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWellKnown(t *testing.T) {
+	for part, want := range map[string]bool{"com": true, "HTTPS": true, "example": false, "john": false} {
+		if got := isWellKnown(part); got != want {
+			t.Errorf("isWellKnown(%v) = %v, want %v", part, got, want)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package chunk
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	hexDigits      = "0123456789abcdef"
+	base32Digits   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+	alphanumerics  = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	separators     = " \t\n-:._"
+	ibanGroupLen   = 4
+	keyGroupLen    = 5
+	hexGroupLen    = 4
+	base32GroupLen = 4
+)
+
+// IBAN is an International Bank Account Number of ISO 13616, like "DE89 3704 0044 0532 0130 00".
+//
+// It is validated by its length and its check digits, computed modulo 97. It is split into groups of four characters.
+var IBAN = Format{
+	Name:        "iban",
+	Description: "IBAN",
+	normalize: func(value string) string {
+		return strings.ToUpper(strip(value, separators))
+	},
+	validate: validateIBAN,
+	split:    fixed(ibanGroupLen),
+}
+
+// ibanLengths are the lengths of IBANs of some countries.
+var ibanLengths = map[string]int{
+	"AT": 20, "BE": 16, "BG": 22, "CH": 21, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "EE": 20, "ES": 24,
+	"FI": 18, "FR": 27, "GB": 22, "GR": 27, "HR": 21, "HU": 28, "IE": 22, "IS": 26, "IT": 27, "LI": 21,
+	"LT": 20, "LU": 20, "LV": 21, "MC": 27, "MT": 31, "NL": 18, "NO": 15, "PL": 28, "PT": 25, "RO": 24,
+	"SE": 24, "SI": 19, "SK": 24, "SM": 27, "TR": 26, "UA": 29,
+}
+
+func validateIBAN(iban string) error {
+	if err := only(alphanumerics, "letter or digit")(iban); err != nil {
+		return err
+	}
+	if len(iban) < 15 || len(iban) > 34 {
+		return fmt.Errorf("it has %d characters instead of 15 to 34", len(iban))
+	}
+	country := iban[:2]
+	if strings.Trim(country, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return fmt.Errorf("it does not start with a country code")
+	}
+	if n, ok := ibanLengths[country]; ok && len(iban) != n {
+		return fmt.Errorf("it has %d characters instead of %d for %s", len(iban), n, country)
+	}
+
+	// Move the country code and check digits to the end and replace letters by numbers, so A is 10 and Z is 35.
+	var digits strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' {
+			fmt.Fprint(&digits, r-'A'+10)
+		} else {
+			digits.WriteRune(r)
+		}
+	}
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	if new(big.Int).Mod(n, big.NewInt(97)).Int64() != 1 {
+		return fmt.Errorf("the check digits %s do not match", iban[2:4])
+	}
+	return nil
+}
+
+// UUID is a Universally Unique Identifier of RFC 4122, like "123e4567-e89b-12d3-a456-426614174000".
+//
+// It is normalized to lower case and split into its five groups of 8, 4, 4, 4 and 12 hexadecimal digits.
+// Braces and the prefix "urn:uuid:" are removed.
+var UUID = Format{
+	Name:        "uuid",
+	Description: "UUID",
+	normalize: func(value string) string {
+		value = strings.ToLower(strings.TrimSpace(value))
+		value = strings.TrimPrefix(value, "urn:uuid:")
+		return strip(value, " \t\n{}-")
+	},
+	validate: func(uuid string) error {
+		if err := only(hexDigits, "hexadecimal digit")(uuid); err != nil {
+			return err
+		}
+		if len(uuid) != 32 {
+			return fmt.Errorf("it has %d hexadecimal digits instead of 32", len(uuid))
+		}
+		return nil
+	},
+	split: func(uuid string) []string {
+		var groups []string
+		for _, n := range []int{8, 4, 4, 4} {
+			if len(uuid) <= n {
+				break
+			}
+			groups = append(groups, uuid[:n])
+			uuid = uuid[n:]
+		}
+		return append(groups, uuid)
+	},
+}
+
+// Hex is a hexadecimal value, like a fingerprint "43:51:43:a1:b5:fc:8b:b7".
+//
+// It is normalized to lower case without separators and the prefix "0x". It is split into groups of four digits.
+var Hex = Format{
+	Name:        "hex",
+	Description: "hexadecimal value",
+	normalize: func(value string) string {
+		value = strings.ToLower(strings.TrimSpace(value))
+		return strip(strings.TrimPrefix(value, "0x"), separators)
+	},
+	validate: only(hexDigits, "hexadecimal digit"),
+	split:    fixed(hexGroupLen),
+}
+
+// Base32 is a value encoded in Base32 of RFC 4648, like the secret of a one time password.
+//
+// It is normalized to upper case without separators and padding. It is split into groups of four characters.
+var Base32 = Format{
+	Name:        "base32",
+	Description: "Base32 value",
+	normalize: func(value string) string {
+		return strings.TrimRight(strings.ToUpper(strip(value, separators)), "=")
+	},
+	validate: only(base32Digits, "Base32 character"),
+	split:    fixed(base32GroupLen),
+}
+
+// Key is a licence or product key, like "ABCDE-12345-FGHIJ".
+//
+// It is normalized to upper case. It is split at hyphens or, without hyphens, into groups of five characters.
+var Key = Format{
+	Name:        "key",
+	Description: "key",
+	normalize: func(value string) string {
+		return strings.ToUpper(strip(value, " \t\n"))
+	},
+	validate: func(key string) error {
+		return only(alphanumerics, "letter or digit")(strip(key, "-"))
+	},
+	split: func(key string) []string {
+		if strings.Contains(key, "-") {
+			return strings.FieldsFunc(key, func(r rune) bool { return r == '-' })
+		}
+		return fixed(keyGroupLen)(key)
+	},
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// command is a sub command of spell. It is invoked by its name as the first argument.
+type command struct {
+	// name invokes the command.
+	name string
+	// args describes the arguments of the command in the synopsis.
+	args string
+	// usage is a one line description of the command.
+	usage string
+	// defineFlags defines the options of the command.
+	defineFlags func(fs *flag.FlagSet)
+	// run executes the command with the parsed options of fs and returns an exit code.
+	run func(fs *flag.FlagSet) int
+}
+
+// commands of spell, sorted by name.
+var commands = []command{
+	compareCommand,
+	decodeCommand,
+	exportSheetCommand,
+	morseCommand,
+	quizCommand,
+	showCommand,
+}
+
+// findCommand returns the command with name.
+func findCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+// flagSet returns a new FlagSet with the options of c.
+func (c command) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet(c.name, flag.ExitOnError)
+	c.defineFlags(fs)
+	fs.Usage = func() {
+		c.printUsage(fs)
+	}
+	return fs
+}
+
+func (c command) synopsis() string {
+	return strings.TrimSpace(fmt.Sprintf("spell %s [options] %s", c.name, c.args))
+}
+
+func (c command) printUsage(fs *flag.FlagSet) {
+	fmt.Fprintf(fs.Output(), "Usage: %s \n\n%s.\n\nOptions:\n", c.synopsis(), c.usage)
+	fs.PrintDefaults()
+}
+
+// execute parses the options of c from args and runs c.
+func (c command) execute(args []string) int {
+	fs := c.flagSet()
+	_ = fs.Parse(args)
+	return c.run(fs)
+}
+
+// errorf reports an error to the user and returns the exit code for errors.
+func errorf(format string, a ...interface{}) int {
+	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", a...)
+	return 1
+}
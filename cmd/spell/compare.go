@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/simonnagl/spell/alphabet"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+var compareCommand = command{
+	name:        "compare",
+	args:        "<alphabet> <alphabet>...",
+	usage:       "Compare spelling alphabets key by key",
+	defineFlags: defineCompareFlags,
+	run:         runCompare,
+}
+
+var (
+	compareFormat *string
+	compareAll    *bool
+)
+
+func defineCompareFlags(fs *flag.FlagSet) {
+	compareFormat = fs.String("format", "text", "Output `format`: text or json")
+	compareAll = fs.Bool("all", false, "List keys with the same word in all alphabets too")
+}
+
+func runCompare(fs *flag.FlagSet) int {
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return 0
+	}
+	if *compareFormat != "text" && *compareFormat != "json" {
+		return errorf("Unknown format '%s'. Use 'text' or 'json'.", *compareFormat)
+	}
+
+	alphabets := make([]alphabet.SpellingAlphabet, 0, fs.NArg())
+	for _, lang := range fs.Args() {
+		a, e := alphabet.Lookup(lang)
+		printExactness(a, e, lang)
+		alphabets = append(alphabets, a)
+	}
+
+	comparisons := alphabet.Compare(alphabets...)
+	var listed []alphabet.Comparison
+	for _, c := range comparisons {
+		if *compareAll || c.Status != alphabet.Same {
+			listed = append(listed, c)
+		}
+	}
+
+	var err error
+	if *compareFormat == "json" {
+		err = compareJSON(alphabets, listed)
+	} else {
+		err = compareText(alphabets, comparisons, listed)
+	}
+	if err != nil {
+		return errorf("Could not compare alphabets: %v", err)
+	}
+	return 0
+}
+
+// compareText prints the listed comparisons as table and counts the status of all comparisons.
+func compareText(alphabets []alphabet.SpellingAlphabet, comparisons []alphabet.Comparison, listed []alphabet.Comparison) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "Key\tStatus")
+	for _, a := range alphabets {
+		fmt.Fprintf(w, "\t%s", a.LangTag())
+	}
+	fmt.Fprintln(w)
+
+	for _, c := range listed {
+		fmt.Fprintf(w, "%s\t%s", visible(c.Upper), strings.ToLower(c.Status.String()))
+		for _, word := range c.Words {
+			if word == "" {
+				word = "-"
+			}
+			fmt.Fprintf(w, "\t%s", word)
+		}
+		fmt.Fprintln(w)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	count := make(map[alphabet.Status]int)
+	for _, c := range comparisons {
+		count[c.Status]++
+	}
+	_, err := fmt.Printf("\n%d keys differ, %d keys are missing in some alphabets, %d keys are the same.\n",
+		count[alphabet.Different], count[alphabet.Missing], count[alphabet.Same])
+	return err
+}
+
+func compareJSON(alphabets []alphabet.SpellingAlphabet, listed []alphabet.Comparison) error {
+	langs := make([]string, 0, len(alphabets))
+	for _, a := range alphabets {
+		langs = append(langs, a.LangTag())
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Alphabets []string              `json:"alphabets"`
+		Keys      []alphabet.Comparison `json:"keys"`
+	}{langs, listed})
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/simonnagl/spell/alphabet"
+	"os"
+	"strings"
+)
+
+var decodeCommand = command{
+	name:        "decode",
+	args:        "<spelled word(s)>",
+	usage:       "Decode word(s) spelled with a spelling alphabet back to text",
+	defineFlags: defineDecodeFlags,
+	run:         runDecode,
+}
+
+var (
+	decodeLang  *string
+	decodeFuzzy *bool
+)
+
+func defineDecodeFlags(fs *flag.FlagSet) {
+	decodeLang = fs.String("l", "en", "Spelling `alphabet` to decode. 'auto' detects the alphabet")
+	decodeFuzzy = fs.Bool("fuzzy", false, "Decode similar words too, like in transcripts of a speech recognition")
+}
+
+func runDecode(fs *flag.FlagSet) int {
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 0
+	}
+
+	spelled := strings.Join(fs.Args(), " ")
+
+	var a alphabet.SpellingAlphabet
+	if *decodeLang == "auto" {
+		a = detectAlphabet(spelled)
+	} else {
+		var e alphabet.Exactness
+		a, e = alphabet.Lookup(*decodeLang)
+		printExactness(a, e, *decodeLang)
+	}
+
+	if *decodeFuzzy {
+		return decodeTranscript(a, spelled)
+	}
+
+	text, unknown := a.Decode(spelled)
+	for _, u := range unknown {
+		printUnknownWord(u.Pos, u.Word)
+	}
+	fmt.Println(text)
+
+	if len(unknown) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// maxRunnerUps is the maximal number of runner-up alphabets reported by detectAlphabet.
+const maxRunnerUps = 3
+
+// detectAlphabet returns the SpellingAlphabet decoding most words of spelled and reports the runner-ups.
+func detectAlphabet(spelled string) alphabet.SpellingAlphabet {
+	detections := alphabet.Detect(spelled)
+	best := detections[0]
+	if best.Score == 0 {
+		a := alphabet.All[0]
+		fmt.Fprintf(os.Stderr, "Warning: Found no spelling alphabet decoding the input. Using default '%s':\n", a.LangTag())
+		return a
+	}
+
+	fmt.Fprintf(os.Stderr, "Info: Detected alphabet '%s' decoding %.0f%% of the words", best.Alphabet.LangTag(), 100*best.Score)
+	for i, d := range detections[1:] {
+		if i == maxRunnerUps || d.Score == 0 {
+			break
+		}
+		if i == 0 {
+			fmt.Fprint(os.Stderr, ". Runner-ups:")
+		} else {
+			fmt.Fprint(os.Stderr, ",")
+		}
+		fmt.Fprintf(os.Stderr, " '%s' %.0f%%", d.Alphabet.LangTag(), 100*d.Score)
+	}
+	fmt.Fprintln(os.Stderr, ":")
+	return best.Alphabet
+}
+
+func decodeTranscript(a alphabet.SpellingAlphabet, transcript string) int {
+	code := 0
+	var sb strings.Builder
+	for _, m := range a.DecodeFuzzy(transcript) {
+		switch {
+		case m.Key == "":
+			printUnknownWord(m.Pos, m.Words)
+			code = 1
+		case m.Confidence < 1:
+			fmt.Fprintf(os.Stderr, "Info: Decoded word %d '%s' as '%s' with confidence %.2f", m.Pos, m.Words, m.Key, m.Confidence)
+			for i, alt := range m.Alternatives {
+				if i == 0 {
+					fmt.Fprint(os.Stderr, ". Alternatives:")
+				}
+				fmt.Fprintf(os.Stderr, " '%s' (%.2f)", alt.Key, alt.Confidence)
+			}
+			fmt.Fprintln(os.Stderr)
+		}
+		sb.WriteString(m.Key)
+	}
+	fmt.Println(sb.String())
+	return code
+}
+
+func printUnknownWord(pos int, word string) {
+	fmt.Fprintf(os.Stderr, "Warning: Could not decode word %d '%s'\n", pos, word)
+}
@@ -1,34 +1,77 @@
 // Spell is a tool to spell word(s) using a spelling alphabet.
 //
 // Usage:
-//     spell [-hlv] <word(s)>
+//     spell [-hlv] [-advise] [-as] [-except] [-format] [-group] [-index] [-layout] [-numbers] [-only] [-strict] [-width] [-words] <word(s)>
 // Options:
+//     -advise=false
+//     	Report characters, which are easily confused like 0 and O, and emphasize their kind
+//     -as=
+//     	Validate and spell word(s) in groups as format: iban, uuid, hex, base32, key, email or url
+//     -except=
+//     	Say characters of the comma separated classes as written, like for -only
+//     -format=text
+//     	Output format: text or json
+//     -group=0
+//     	Insert a header before every n characters in the numbered layout. 0 disables the headers
 //     -h=false
 //     	Print this usage note
+//     -index=0
+//     	Mark the position of every nth character in the interlinear layout. 0 disables the marks
 //     -l=en
-//     	Spelling alphabet to use
+//     	Spelling alphabet to use, by language tag like de-DE or by name like NATO. A language tag selects the default alphabet of its language, variants are selected with a private use tag like en-x-maritime. A comma separated list spells with each alphabet
+//     -layout=plain
+//     	Text layout: plain, interlinear, which aligns the words under their characters, or numbered, which writes one character per line with its position
+//     -numbers=digits
+//     	Read numbers as digits, which spells each digit, cardinal, which reads them as numbers of the language, like one hundred twenty-three, or icao, which reads them in radiotelephony, like Flight Level One Eight Zero
+//     -only=
+//     	Spell only characters of the comma separated classes: letters, digits, symbols, confusable or non-ascii. Say all others as written
+//     -strict=false
+//     	Exit with an error on letters of a different script than their word or the alphabet, like a Cyrillic а in a Latin word, and on look-alikes of the letters of the alphabet, like a fullwidth ａ
 //     -v=false
 //     	Print version info
+//     -width=0
+//     	Maximal width of lines. Defaults to the terminal width, the environment variable COLUMNS or 80
+//     -words=false
+//     	Say the well-known scheme, www and top-level domain of emails and URLs, like https or com, as words
+// Commands:
+//     spell compare [options] <alphabet> <alphabet>...
+//     	Compare spelling alphabets key by key
+//     spell decode [options] <spelled word(s)>
+//     	Decode word(s) spelled with a spelling alphabet back to text
+//     spell export-sheet [options]
+//     	Export a spelling alphabet as printable cheat sheet or flashcards
+//     spell morse [options] <word(s)>
+//     	Write word(s) in Morse code
+//     spell quiz [options]
+//     	Train a spelling alphabet in timed rounds. Missed characters come back more often
+//     spell show [options] <alphabet>
+//     	Show all characters of a spelling alphabet with their words
 // Spelling alphabets:
-//     cs      Czech
-//     da      Danish
-//     de-AT   Austrian German
-//     de-CH   Swiss High German
-//     de-DE   German (Germany)
-//     en      English
-//     en-GB   British English
-//     es      Spanish
-//     fi      Finnish
-//     fr      French
-//     it      Italian
-//     nl      Dutch
-//     no      Norwegian Bokmål
-//     pt-BR   Brazilian Portuguese
-//     pt-PT   European Portuguese
-//     ro      Romanian
-//     ru      Russian
-//     sl      Slovenian
-//     sv      Swedish
-//     tr      Turkish
-//     uk      Ukrainian
+//     cs                   Czech
+//     da                   Danish
+//     de-AT                Austrian German
+//     de-CH                Swiss High German
+//     de-DE                German (Germany)
+//     de-DE-x-din2022      German (Germany)
+//     en                   English
+//     en-x-able            English
+//     en-x-apco            English
+//     en-x-maritime        English
+//     en-GB                British English
+//     en-GB-x-raf1942      British English
+//     es                   Spanish
+//     fi                   Finnish
+//     fr                   French
+//     it                   Italian
+//     nl                   Dutch
+//     no                   Norwegian Bokmål
+//     pl                   Polish
+//     pt-BR                Brazilian Portuguese
+//     pt-PT                European Portuguese
+//     ro                   Romanian
+//     ru                   Russian
+//     sl                   Slovenian
+//     sv                   Swedish
+//     tr                   Turkish
+//     uk                   Ukrainian
 package main
@@ -28,9 +28,19 @@ spell - spell word(s) using a spelling alphabet.
 {{ range .Options }}
 *-{{ .Name }}* {{ .Type }}:: {{ .Usage }} (Default: {{ .DefValue }}){{ end }}
 
+== Commands
+{{ range .Commands }}
+=== {{ .Name }}
+
+{{ .Usage }}.
+
+	{{ .Synopsis }}
+{{ range .Options }}
+*-{{ .Name }}* {{ .Type }}:: {{ .Usage }} (Default: {{ .DefValue }}){{ end }}
+{{ end }}
 == Spelling alphabets
 {{ range .Alphabets}}
-*{{ .LangTag }}* :: {{ .LangEnglishName }}{{ if ne .AltNames ""}} -- {{ .AltNames }}{{ end }}{{ end }}
+*{{ .LangTag }}* :: {{ .LangEnglishName }}{{ if ne .AltNames ""}} -- {{ .AltNames }}{{ end }}. {{ if ne .Reference "" }}Standard: {{ .Reference }}, {{ .Status }}.{{ else }}Informal.{{ end }}{{ end }}
 
 == Examples
 
@@ -73,12 +83,23 @@ Download from https://github.com/simonnagl/spell/releases[GitHub Releases].
 {{ range .Options }}
 *-{{ .Name }}* {{ .Type }}:: {{ .Usage }} (Default: {{ .DefValue }}){{ end }}
 
+== Commands
+{{ range .Commands }}
+=== {{ .Name }}
+
+{{ .Usage }}.
+
+	{{ .Synopsis }}
+{{ range .Options }}
+*-{{ .Name }}* {{ .Type }}:: {{ .Usage }} (Default: {{ .DefValue }}){{ end }}
+{{ end }}
 == Spelling alphabets
 
-[cols="h,3*"]
+[cols="h,5*"]
 |===
+| Tag | Language | Name | Also known as | Standard | Status
 {{ range .Alphabets}}
-| {{ .LangTag }} | {{ .LangEnglishName }} | {{ .LangSelfName }} |{{if ne .AltNames ""}} {{ .AltNames }}{{end}}{{ end }}
+| {{ .LangTag }} | {{ .LangEnglishName }} | {{ .LangSelfName }} |{{if ne .AltNames ""}} {{ .AltNames }}{{end}} |{{if ne .Reference ""}} {{ .Reference }}{{end}} | {{ .Status }}{{ end }}
 
 |===
 
@@ -101,8 +122,11 @@ const godocTmpl = `// Spell is a tool to spell word(s) using a spelling alphabet
 // Options:{{ range .Options }}
 //     -{{ .Name }}={{ .DefValue }}
 //     	{{ .Usage }}{{ end }}
+// Commands:{{ range .Commands }}
+//     {{ .Synopsis }}
+//     	{{ .Usage }}{{ end }}
 // Spelling alphabets:{{ range .Alphabets }}
-//     {{ printf "%-8v" .LangTag }}{{ .LangEnglishName }}{{end}}
+//     {{ printf "%-*v" $.LangTagWidth .LangTag }}{{ .LangEnglishName }}{{end}}
 package main
 `
 
@@ -169,7 +193,10 @@ func genDoc(t *testing.T, tmplString string) string {
 type Data struct {
 	Synopsis  string
 	Options   []Flag
+	Commands  []Command
 	Alphabets []alphabetView
+	// LangTagWidth is the width of the column of language tags in a list of alphabets.
+	LangTagWidth int
 }
 
 func data() Data {
@@ -178,9 +205,11 @@ func data() Data {
 	DefineFlags()
 
 	return Data{
-		Synopsis:  synopsis(),
-		Options:   flags(),
-		Alphabets: alphabetViewModel(),
+		Synopsis:     synopsis(),
+		Options:      flags(flag.CommandLine),
+		Commands:     commandsData(),
+		Alphabets:    alphabetViewModel(),
+		LangTagWidth: langTagWidth(alphabetViewModel()) + 2,
 	}
 }
 
@@ -191,15 +220,30 @@ type Flag struct {
 	DefValue string
 }
 
-func flags() []Flag {
+func flags(fs *flag.FlagSet) []Flag {
 	var r []Flag
-	flag.VisitAll(func(f *flag.Flag) {
+	fs.VisitAll(func(f *flag.Flag) {
 		fType, fUsage := flag.UnquoteUsage(f)
 		r = append(r, Flag{f.Name, fType, fUsage, f.DefValue})
 	})
 	return r
 }
 
+type Command struct {
+	Name     string
+	Synopsis string
+	Usage    string
+	Options  []Flag
+}
+
+func commandsData() []Command {
+	var r []Command
+	for _, c := range commands {
+		r = append(r, Command{c.name, c.synopsis(), c.usage, flags(c.flagSet())})
+	}
+	return r
+}
+
 func writeDoc(t *testing.T, generatedManpage string, fName string) {
 	f, err := os.Create(fName)
 	if err != nil {
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"github.com/simonnagl/spell/alphabet"
+	"golang.org/x/text/width"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// columnGap is the number of spaces between two columns of a layout.
+const columnGap = 2
+
+// defaultWidth is the line width, if the width of the terminal is unknown.
+const defaultWidth = 80
+
+// layoutOptions configure a layout.
+type layoutOptions struct {
+	// Width is the maximal width of a line.
+	Width int
+	// Index marks every Index characters with their position. 0 disables the marks.
+	Index int
+	// Group inserts a header before every Group characters. 0 disables the headers.
+	Group int
+}
+
+// layout arranges the tokens of a spelled text for printing.
+type layout func(tokens []alphabet.Token, o layoutOptions) string
+
+// layouts selectable by their name.
+var layouts = map[string]layout{
+	"plain":       plainLayout,
+	"interlinear": interlinearLayout,
+	"numbered":    numberedLayout,
+}
+
+// plainLayout writes all phonetic forms in one line, just like Spell.
+func plainLayout(tokens []alphabet.Token, _ layoutOptions) string {
+	words := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if t.Word != "" {
+			words = append(words, t.Word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// interlinearLayout writes the spelled characters in one line and their phonetic forms aligned underneath.
+// Lines wrap at the width of o. An optional line above the characters marks their positions, if there are any.
+func interlinearLayout(tokens []alphabet.Token, o layoutOptions) string {
+	var blocks []string
+	var index, chars, words strings.Builder
+	var lineWidth int
+	flush := func() {
+		if lineWidth == 0 {
+			return
+		}
+		var lines []string
+		if marks := strings.TrimRight(index.String(), " "); marks != "" {
+			lines = append(lines, marks)
+		}
+		lines = append(lines, strings.TrimRight(chars.String(), " "), strings.TrimRight(words.String(), " "))
+		blocks = append(blocks, strings.Join(lines, "\n"))
+		index.Reset()
+		chars.Reset()
+		words.Reset()
+		lineWidth = 0
+	}
+
+	var pos int
+	for _, t := range tokens {
+		char := visible(t.Text)
+		n := len(alphabet.Graphemes(t.Text))
+		var mark string
+		if o.Index > 0 {
+			if m := (pos + n) / o.Index * o.Index; m > pos {
+				mark = strconv.Itoa(m)
+			}
+		}
+		pos += n
+		if t.Word == "" {
+			continue
+		}
+
+		w := displayWidth(char)
+		if ww := displayWidth(t.Word); ww > w {
+			w = ww
+		}
+		if len(mark) > w {
+			w = len(mark)
+		}
+
+		if lineWidth > 0 && lineWidth+columnGap+w > o.Width {
+			flush()
+		}
+		if lineWidth > 0 {
+			gap := strings.Repeat(" ", columnGap)
+			index.WriteString(gap)
+			chars.WriteString(gap)
+			words.WriteString(gap)
+			lineWidth += columnGap
+		}
+		pad(&index, mark, w)
+		pad(&chars, char, w)
+		pad(&words, t.Word, w)
+		lineWidth += w
+	}
+	flush()
+	return strings.Join(blocks, "\n\n")
+}
+
+// numberedLayout writes one token per line together with its position.
+// Positions count grapheme clusters, so a character with combining marks is one position.
+// Optional headers divide the lines into groups of characters, like "Group 3: KL12".
+func numberedLayout(tokens []alphabet.Token, o layoutOptions) string {
+	positions := make([]int, len(tokens))
+	var charWidth int
+	pos := 1
+	for i, t := range tokens {
+		positions[i] = pos
+		pos += len(alphabet.Graphemes(t.Text))
+		if w := displayWidth(visible(t.Text)); w > charWidth {
+			charWidth = w
+		}
+	}
+	posWidth := len(strconv.Itoa(pos - 1))
+
+	var lines []string
+	group := -1
+	for i, t := range tokens {
+		if t.Word == "" {
+			continue
+		}
+		if o.Group > 0 && (positions[i]-1)/o.Group != group {
+			group = (positions[i] - 1) / o.Group
+			var text strings.Builder
+			for j := i; j < len(tokens) && (positions[j]-1)/o.Group == group; j++ {
+				text.WriteString(visible(tokens[j].Text))
+			}
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, fmt.Sprintf("Group %d: %s", group+1, text.String()))
+		}
+
+		var line strings.Builder
+		fmt.Fprintf(&line, "%*d  ", posWidth, positions[i])
+		pad(&line, visible(t.Text), charWidth)
+		fmt.Fprintf(&line, "  %s", t.Word)
+		lines = append(lines, line.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// pad writes s filled up with spaces to the display width w.
+func pad(sb *strings.Builder, s string, w int) {
+	sb.WriteString(s)
+	if n := w - displayWidth(s); n > 0 {
+		sb.WriteString(strings.Repeat(" ", n))
+	}
+}
+
+// displayWidth returns the number of terminal columns s occupies.
+//
+// Each grapheme cluster occupies the width of its first character: two columns for East Asian wide characters,
+// like most emoji, and none for combining marks and format characters on their own.
+func displayWidth(s string) int {
+	var n int
+	for _, cluster := range alphabet.Graphemes(s) {
+		r, _ := utf8.DecodeRuneInString(cluster)
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			n += 2
+		default:
+			if !unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// terminalWidth returns the width of lines. It defaults to the width of the terminal, if stdout is one, and otherwise
+// to the environment variable COLUMNS, like for output piped into a pager.
+func terminalWidth(w int) int {
+	if w > 0 {
+		return w
+	}
+	if columns := ttyColumns(os.Stdout.Fd()); columns > 0 {
+		return columns
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return defaultWidth
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"Alfa", 4},
+		{"日本", 4},
+		{"ｱ", 1},
+		{"é", 1},
+		{"👩‍💻", 2},
+		{"e\u0301", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := displayWidth(tt.s); got != tt.want {
+				t.Errorf("displayWidth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerminalWidth(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	stdout, columns := os.Stdout, os.Getenv("COLUMNS")
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+		_ = os.Setenv("COLUMNS", columns)
+	}()
+
+	_ = os.Setenv("COLUMNS", "100")
+	if got := terminalWidth(40); got != 40 {
+		t.Errorf("terminalWidth(40) = %v, want 40", got)
+	}
+	if got := terminalWidth(0); got != 100 {
+		t.Errorf("terminalWidth(0) with COLUMNS=100 and no terminal = %v, want 100", got)
+	}
+	_ = os.Setenv("COLUMNS", "")
+	if got := terminalWidth(0); got != defaultWidth {
+		t.Errorf("terminalWidth(0) without COLUMNS and no terminal = %v, want %v", got, defaultWidth)
+	}
+}
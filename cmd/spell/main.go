@@ -1,9 +1,13 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"github.com/simonnagl/spell/alphabet"
+	"github.com/simonnagl/spell/chunk"
+	"github.com/simonnagl/spell/numeral"
+	"golang.org/x/text/language"
 	"os"
 	"sort"
 	"strings"
@@ -13,9 +17,40 @@ var (
 	printHelp    *bool
 	printVersion *bool
 	lang         *string
+	format       *string
+	layoutName   *string
+	layoutWidth  *int
+	layoutIndex  *int
+	layoutGroup  *int
+	as           *string
+	sayWords     *bool
+	strict       *bool
+	advise       *bool
+	only         *string
+	except       *string
+	numbers      *string
 )
 
+// selected reports whether a token is spelled. Tokens, which are not selected, are said as written.
+var selected = func(alphabet.Token) bool { return true }
+
+// words reports for each part of a formatted text, whether it is said as a word instead of being spelled.
+var words []bool
+
 func main() {
+	if code := run(); code != 0 {
+		os.Exit(code)
+	}
+}
+
+// run executes spell and returns its exit code.
+func run() int {
+	if len(os.Args) > 1 {
+		if c, ok := findCommand(os.Args[1]); ok {
+			return c.execute(os.Args[2:])
+		}
+	}
+
 	flag.CommandLine.Usage = printUsage
 	DefineFlags()
 
@@ -23,32 +58,379 @@ func main() {
 
 	if *printHelp {
 		printUsage()
-		return
+		return 0
 	}
 	if *printVersion {
 		fmt.Fprintln(flag.CommandLine.Output(), "spell", Version)
-		return
+		return 0
 	}
 	if nothingToSpell() {
 		printUsage()
-		return
+		return 0
 	}
 
-	a, e := alphabet.Lookup(*lang)
+	var langs []string
+	for _, l := range strings.Split(*lang, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			langs = append(langs, l)
+		}
+	}
+	if len(langs) == 0 {
+		// Let Lookup fall back to the default alphabet and warn about it.
+		langs = []string{*lang}
+	}
 	args := strings.Join(flag.Args(), " ")
 
+	if *numbers != "digits" && *numbers != "cardinal" && *numbers != "icao" {
+		return errorf("Unknown numbers '%s'. Use 'digits', 'cardinal' or 'icao'.", *numbers)
+	}
+	if err := selectClasses(*only, *except); err != nil {
+		return errorf("%v. Use %s.", err, classNames())
+	}
+
+	parts := []string{args}
+	code := 0
+	if *as != "" {
+		f, ok := chunk.Lookup(*as)
+		if !ok {
+			return errorf("Unknown format '%s'. Use %s.", *as, formatNames())
+		}
+		var err error
+		if parts, err = f.Split(args); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: The %s '%s' is invalid: %v.\n", f.Description, f.Normalize(args), err)
+			code = 1
+		}
+		words = nil
+		if *sayWords {
+			words = f.Words(parts)
+		}
+	}
+
+	if warnHomoglyphs(langs, args) && *strict {
+		code = 1
+	}
+	if *advise {
+		printAdvisories(langs[0], args)
+	}
+	if *numbers == "cardinal" {
+		warnNumberLanguages(langs)
+	}
+
+	switch *format {
+	case "text":
+		l, ok := layouts[*layoutName]
+		if !ok {
+			return errorf("Unknown layout '%s'. Use 'plain', 'interlinear' or 'numbered'.", *layoutName)
+		}
+		spellText(langs, parts, l, layoutOptions{terminalWidth(*layoutWidth), *layoutIndex, *layoutGroup})
+	case "json":
+		if err := spellJSON(langs, args, parts); err != nil {
+			return errorf("Could not write JSON: %v", err)
+		}
+	default:
+		return errorf("Unknown format '%s'. Use 'text' or 'json'.", *format)
+	}
+	return code
+}
+
+// warnHomoglyphs warns about letters in text, which look like letters of the alphabets of langs, but are not.
+// It reports whether it found any.
+func warnHomoglyphs(langs []string, text string) bool {
+	warned := make(map[string]bool)
+	for _, l := range langs {
+		a, _ := alphabet.Lookup(l)
+		for _, h := range a.Homoglyphs(text) {
+			warning := fmt.Sprintf("Warning: Character %d '%c' is %v\n", h.Pos, h.Char, h)
+			if !warned[warning] {
+				fmt.Fprint(os.Stderr, warning)
+				warned[warning] = true
+			}
+		}
+	}
+	return len(warned) > 0
+}
+
+// printAdvisories informs about characters in text, which are easily confused with others when read.
+func printAdvisories(lang string, text string) {
+	a, _ := alphabet.Lookup(lang)
+	for _, advisory := range a.Advise(text) {
+		fmt.Fprintf(os.Stderr, "Info: Character %d %v\n", advisory.Pos, advisory)
+	}
+}
+
+// warnNumberLanguages warns about alphabets of langs, whose numbers are read in another language.
+func warnNumberLanguages(langs []string) {
+	for _, l := range langs {
+		a, _ := alphabet.Lookup(l)
+		if n, ok := numberLanguage(a); !ok {
+			fmt.Fprintf(os.Stderr, "Warning: Found no number words for '%s'. Reading numbers in '%s'.\n", a.LangTag(), n.LangTag())
+		}
+	}
+}
+
+// numberLanguage returns the Language reading the numbers of a and reports whether it is the language of a.
+func numberLanguage(a alphabet.SpellingAlphabet) (numeral.Language, bool) {
+	n := numeral.Lookup(a.LangTag())
+	want, _ := language.Make(a.LangTag()).Base()
+	got, _ := language.Make(n.LangTag()).Base()
+	return n, want == got
+}
+
+// tokenize splits text into tokens of a. Numbers are read as cardinal numbers in the language of a or in radiotelephony,
+// if the user asked for it. Tokens, which are not selected, are merged into literal words.
+func tokenize(a alphabet.SpellingAlphabet, text string) []alphabet.Token {
+	var r numeral.Reader
+	switch *numbers {
+	case "cardinal":
+		r, _ = numberLanguage(a)
+	case "icao":
+		r = numeral.ICAO
+	}
+
+	var tokens []alphabet.Token
+	start := 0
+	if r != nil {
+		for _, n := range r.Find(text) {
+			tokens = append(tokens, spellTokens(a, text[start:n.Start])...)
+			tokens = append(tokens, alphabet.Token{Text: text[n.Start:n.End], Word: r.Read(n), Kind: alphabet.Digit})
+			start = n.End
+		}
+	}
+	tokens = append(tokens, spellTokens(a, text[start:])...)
+	return alphabet.Select(tokens, selected)
+}
+
+// spellTokens splits text into tokens of a. Ambiguous characters are emphasized, if the user asked for advise.
+func spellTokens(a alphabet.SpellingAlphabet, text string) []alphabet.Token {
+	tokens := a.Tokenize(text)
+	if *advise {
+		for i, t := range tokens {
+			tokens[i].Word = a.Emphasize(t)
+		}
+	}
+	return tokens
+}
+
+// selectClasses selects the tokens of the comma separated classes in only, except the ones of the classes in except.
+// An empty only selects all classes.
+func selectClasses(only string, except string) error {
+	onlyClasses, err := parseClasses(only)
+	if err != nil {
+		return err
+	}
+	exceptClasses, err := parseClasses(except)
+	if err != nil {
+		return err
+	}
+
+	selected = func(t alphabet.Token) bool {
+		for _, c := range exceptClasses {
+			if c.Contains(t) {
+				return false
+			}
+		}
+		for _, c := range onlyClasses {
+			if c.Contains(t) {
+				return true
+			}
+		}
+		return len(onlyClasses) == 0
+	}
+	return nil
+}
+
+func parseClasses(list string) ([]alphabet.Class, error) {
+	var classes []alphabet.Class
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		c, err := alphabet.ParseClass(name)
+		if err != nil {
+			return nil, err
+		}
+		classes = append(classes, c)
+	}
+	return classes, nil
+}
+
+// classNames lists the names of all classes for users, like "'letters', 'digits' or 'symbols'".
+func classNames() string {
+	var names []string
+	for c := alphabet.Letters; c <= alphabet.NonASCII; c++ {
+		names = append(names, "'"+c.String()+"'")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// formatNames lists the names of all chunk formats for users, like "'iban', 'uuid' or 'key'".
+func formatNames() string {
+	names := make([]string, 0, len(chunk.Formats))
+	for _, f := range chunk.Formats {
+		names = append(names, "'"+f.Name+"'")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// spellText spells the parts of a text with the alphabet of each language in langs and arranges them with layout l.
+// Several alphabets print one block each, labeled with the language tag of the alphabet.
+func spellText(langs []string, parts []string, l layout, o layoutOptions) {
+	if len(langs) == 1 {
+		a, e := alphabet.Lookup(langs[0])
+		printExactness(a, e, langs[0])
+		fmt.Println(spellParts(a, parts, l, o))
+		return
+	}
+
+	alphabets := make([]alphabet.SpellingAlphabet, len(langs))
+	exactness := make([]alphabet.Exactness, len(langs))
+	var width int
+	for i, l := range langs {
+		alphabets[i], exactness[i] = alphabet.Lookup(l)
+		if n := len(alphabets[i].LangTag()); n > width {
+			width = n
+		}
+	}
+	o.Width -= width + columnGap
+	for i, a := range alphabets {
+		printExactness(a, exactness[i], langs[i])
+		fmt.Println(labelBlock(a.LangTag(), width, spellParts(a, parts, l, o)))
+	}
+}
+
+// spellParts spells the parts of a text with a and arranges them with layout l.
+// Several parts are spelled one after another, each labeled with the part.
+func spellParts(a alphabet.SpellingAlphabet, parts []string, l layout, o layoutOptions) string {
+	if len(parts) == 1 {
+		return l(tokenize(a, parts[0]), o)
+	}
+
+	var width int
+	for _, p := range parts {
+		if w := displayWidth(p); w > width {
+			width = w
+		}
+	}
+	o.Width -= width + columnGap
+	blocks := make([]string, 0, len(parts))
+	for i, p := range parts {
+		block := p
+		if !saidAsWord(i) {
+			block = l(tokenize(a, p), o)
+		}
+		blocks = append(blocks, labelBlock(p, width, block))
+	}
+	return strings.Join(blocks, "\n")
+}
+
+// saidAsWord reports whether the part i of a text is said as a word instead of being spelled, like "com" of a URL.
+func saidAsWord(i int) bool {
+	return i < len(words) && words[i]
+}
+
+// labelBlock labels the first line of block, padded to width. All further lines are indented to align with it.
+func labelBlock(label string, width int, block string) string {
+	lines := strings.Split(block, "\n")
+	for i, line := range lines {
+		var sb strings.Builder
+		pad(&sb, label, width)
+		sb.WriteString(strings.Repeat(" ", columnGap))
+		sb.WriteString(line)
+		lines[i] = strings.TrimRight(sb.String(), " ")
+		label = ""
+	}
+	return strings.Join(lines, "\n")
+}
+
+// spelling is the result of spelling a text with one alphabet.
+type spelling struct {
+	// Lang is the requested language.
+	Lang      string             `json:"lang"`
+	Alphabet  string             `json:"alphabet"`
+	Exactness alphabet.Exactness `json:"exactness"`
+	Spelled   string             `json:"spelled"`
+	// Groups are the spelled parts of a formatted text.
+	Groups []spelledGroup `json:"groups,omitempty"`
+}
+
+// spelledGroup is a spelled part of a formatted text, like the first four characters of an IBAN.
+type spelledGroup struct {
+	Text    string `json:"text"`
+	Spelled string `json:"spelled"`
+}
+
+// spellJSON writes the spelling of text with the alphabet of each language in langs as JSON.
+// Several parts of text are spelled as groups, which make up the spelling of the whole text.
+func spellJSON(langs []string, text string, parts []string) error {
+	result := struct {
+		Text      string     `json:"text"`
+		Spellings []spelling `json:"spellings"`
+	}{Text: text}
+	for _, l := range langs {
+		a, e := alphabet.Lookup(l)
+		s := spelling{Lang: l, Alphabet: a.LangTag(), Exactness: e}
+		if len(parts) == 1 {
+			s.Spelled = plainLayout(tokenize(a, parts[0]), layoutOptions{})
+		} else {
+			spelled := make([]string, 0, len(parts))
+			for i, p := range parts {
+				g := spelledGroup{p, p}
+				if !saidAsWord(i) {
+					g.Spelled = plainLayout(tokenize(a, p), layoutOptions{})
+				}
+				s.Groups = append(s.Groups, g)
+				spelled = append(spelled, g.Spelled)
+			}
+			s.Spelled = strings.Join(spelled, " ")
+		}
+		result.Spellings = append(result.Spellings, s)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// printExactness informs the user, if the SpellingAlphabet a is not exactly the one requested by lang.
+func printExactness(a alphabet.SpellingAlphabet, e alphabet.Exactness, lang string) {
 	switch e {
 	case alphabet.Guess:
-		fmt.Fprintf(os.Stderr, "Info: Guess alphabet '%s' for input '%s':\n", a.LangTag(), *lang)
+		fmt.Fprintf(os.Stderr, "Info: Guess alphabet '%s' for input '%s':\n", a.LangTag(), lang)
 	case alphabet.Default:
-		fmt.Fprintf(os.Stderr, "Warning: Found no spelling alphabet for '%s'. Using default '%s':\n", *lang, a.LangTag())
+		fmt.Fprintf(os.Stderr, "Warning: Found no spelling alphabet for '%s'. Using default '%s':\n", lang, a.LangTag())
+	}
+}
+
+// alphabetTitle names the SpellingAlphabet a in its own language together with its standards, like "Deutsch — DIN 5009".
+func alphabetTitle(a alphabet.SpellingAlphabet) string {
+	if len(a.Names()) == 0 {
+		return a.LangSelfName()
 	}
+	return a.LangSelfName() + " — " + strings.Join(a.Names(), ", ")
+}
 
-	fmt.Println(a.Spell(args))
+// visible returns key or "␣", if key is a space.
+func visible(key string) string {
+	if key == " " {
+		return "␣"
+	}
+	return key
 }
 
 func DefineFlags() {
-	lang = flag.String("l", "en", "Spelling `alphabet` to use")
+	lang = flag.String("l", "en", "Spelling `alphabet` to use, by language tag like de-DE or by name like NATO. A language tag selects the default alphabet of its language, variants are selected with a private use tag like en-x-maritime. A comma separated list spells with each alphabet")
+	format = flag.String("format", "text", "Output `format`: text or json")
+	layoutName = flag.String("layout", "plain", "Text `layout`: plain, interlinear, which aligns the words under their characters, or numbered, which writes one character per line with its position")
+	layoutWidth = flag.Int("width", 0, "Maximal `width` of lines. Defaults to the terminal width, the environment variable COLUMNS or 80")
+	layoutIndex = flag.Int("index", 0, "Mark the position of every `n`th character in the interlinear layout. 0 disables the marks")
+	as = flag.String("as", "", "Validate and spell word(s) in groups as `format`: iban, uuid, hex, base32, key, email or url")
+	strict = flag.Bool("strict", false, "Exit with an error on letters of a different script than their word or the alphabet, like a Cyrillic а in a Latin word, and on look-alikes of the letters of the alphabet, like a fullwidth ａ")
+	advise = flag.Bool("advise", false, "Report characters, which are easily confused like 0 and O, and emphasize their kind")
+	only = flag.String("only", "", "Spell only characters of the comma separated `classes`: letters, digits, symbols, confusable or non-ascii. Say all others as written")
+	except = flag.String("except", "", "Say characters of the comma separated `classes` as written, like for -only")
+	numbers = flag.String("numbers", "digits", "Read `numbers` as digits, which spells each digit, cardinal, which reads them as numbers of the language, like one hundred twenty-three, or icao, which reads them in radiotelephony, like Flight Level One Eight Zero")
+	sayWords = flag.Bool("words", false, "Say the well-known scheme, www and top-level domain of emails and URLs, like https or com, as words")
+	layoutGroup = flag.Int("group", 0, "Insert a header before every `n` characters in the numbered layout. 0 disables the headers")
 	printHelp = flag.Bool("h", false, "Print this usage note")
 	printVersion = flag.Bool("v", false, "Print version info")
 }
@@ -57,57 +439,111 @@ func nothingToSpell() bool {
 	return len(flag.Args()) == 0
 }
 
+// synopsis combines all single letter options like "[-hlv]" and lists longer options separately.
 func synopsis() string {
 	var allName string
+	var long []string
 	flag.VisitAll(func(flag *flag.Flag) {
-		allName += flag.Name
+		if len(flag.Name) == 1 {
+			allName += flag.Name
+		} else {
+			long = append(long, fmt.Sprintf(" [-%s]", flag.Name))
+		}
 	})
 
-	return fmt.Sprintf("spell [-%s] <word(s)>", allName)
+	return fmt.Sprintf("spell [-%s]%s <word(s)>", allName, strings.Join(long, ""))
 }
 
 func printUsage() {
 	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s \n\nOptions:\n", synopsis())
 	flag.CommandLine.PrintDefaults()
+	fmt.Fprintf(flag.CommandLine.Output(), "\nCommands:\n")
+	printCommands()
 	fmt.Fprintf(flag.CommandLine.Output(), "\nSpelling alphabets:\n")
 	printAlphabets()
 }
 
+func printCommands() {
+	for _, c := range commands {
+		fmt.Fprintf(flag.CommandLine.Output(), "  %-14v%v\n", c.name, c.usage)
+	}
+	fmt.Fprintf(flag.CommandLine.Output(), "\nRun 'spell <command> -h' for the options of a command.\n")
+}
+
 type alphabetView struct {
 	LangTag         string
 	LangEnglishName string
 	LangSelfName    string
 	AltNames        string
+	// Variant of the alphabet, like "maritime" of "en-x-maritime". It is empty for the default of a language tag.
+	Variant string
+	// Reference names the defining standard, like "DIN 5009 (2022)". It is empty for informal alphabets.
+	Reference string
+	// Status tells whether the standard is in force, like "current".
+	Status string
 }
 
+// alphabetViewModel lists the default alphabets sorted by language tag, each followed by its variants.
 func alphabetViewModel() []alphabetView {
-
-	allAlphabetView := make([]alphabetView, 0, len(alphabet.All))
-
+	var defaults []alphabet.SpellingAlphabet
 	for _, a := range alphabet.All {
-		allAlphabetView = append(allAlphabetView, alphabetView{
-			LangTag:         a.LangTag(),
-			LangEnglishName: a.LangEnglishName(),
-			LangSelfName:    a.LangSelfName(),
-			AltNames:        strings.Join(a.Names(), ", "),
-		})
+		if a.Variant() == "" {
+			defaults = append(defaults, a)
+		}
 	}
-
-	sort.Slice(allAlphabetView, func(i int, j int) bool {
-		return allAlphabetView[i].LangTag < allAlphabetView[j].LangTag
+	sort.Slice(defaults, func(i int, j int) bool {
+		return defaults[i].LangTag() < defaults[j].LangTag()
 	})
 
+	allAlphabetView := make([]alphabetView, 0, len(alphabet.All))
+	for _, d := range defaults {
+		for _, a := range append([]alphabet.SpellingAlphabet{d}, d.Variants()...) {
+			allAlphabetView = append(allAlphabetView, alphabetView{
+				LangTag:         a.LangTag(),
+				LangEnglishName: a.LangEnglishName(),
+				LangSelfName:    a.LangSelfName(),
+				AltNames:        strings.Join(a.Names(), ", "),
+				Variant:         a.Variant(),
+				Reference:       a.Metadata().Reference(),
+				Status:          strings.ToLower(a.Metadata().Status.String()),
+			})
+		}
+	}
 	return allAlphabetView
 }
 
+// variantIndent indents variants under the default alphabet of their language tag.
+const variantIndent = "  "
+
+// langTagWidth returns the width of the column of language tags in a list of alphabets.
+func langTagWidth(alphabets []alphabetView) int {
+	width := 6
+	for _, a := range alphabets {
+		w := len(a.LangTag) + 2
+		if a.Variant != "" {
+			w += len(variantIndent)
+		}
+		if w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// printAlphabets lists all alphabets. Variants are listed with their names under the default alphabet of their
+// language tag.
 func printAlphabets() {
 	allAlphabet := alphabetViewModel()
+	width := langTagWidth(allAlphabet)
 
 	for _, f := range allAlphabet {
-		if "" != f.AltNames {
-			fmt.Fprintf(flag.CommandLine.Output(), "  %-6v%v, %v\n", f.LangTag, f.LangEnglishName, f.AltNames)
-		} else {
-			fmt.Fprintf(flag.CommandLine.Output(), "  %-6v%v\n", f.LangTag, f.LangEnglishName)
+		switch {
+		case f.Variant != "":
+			fmt.Fprintf(flag.CommandLine.Output(), "  %-*v%v\n", width, variantIndent+f.LangTag, f.AltNames)
+		case "" != f.AltNames:
+			fmt.Fprintf(flag.CommandLine.Output(), "  %-*v%v, %v\n", width, f.LangTag, f.LangEnglishName, f.AltNames)
+		default:
+			fmt.Fprintf(flag.CommandLine.Output(), "  %-*v%v\n", width, f.LangTag, f.LangEnglishName)
 		}
 	}
 }
@@ -2,9 +2,12 @@ package main
 
 import (
 	"bytes"
+	"github.com/simonnagl/spell/alphabet"
 	"github.com/simonnagl/spell/test"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -20,20 +23,30 @@ func TestMain_EmtpyArgs(t *testing.T) {
 	}
 }
 
-func testMain(t *testing.T, arg string, expected string) {
+func testMain(t *testing.T, expected string, args ...string) {
+	testMainExitCode(t, 0, expected, args...)
+}
+
+func testMainExitCode(t *testing.T, expectedCode int, expected string, args ...string) {
 	cleanup := test.ClearCommandLine()
 	defer cleanup()
 
-	os.Args = append(os.Args, arg)
+	os.Args = append(os.Args, args...)
 
-	o, err := captureOutput(main)
+	var code int
+	o, err := captureOutput(func() {
+		code = run()
+	})
 	if err != nil {
-		t.Fatal("Could not capture output of main().", err)
+		t.Fatal("Could not capture output of run().", err)
 	}
 
 	if expected != o {
 		t.Errorf("Expected output does not match.\ngot:\n%s\nwant:\n%s", o, expected)
 	}
+	if expectedCode != code {
+		t.Errorf("Exit code = %d, want %d", code, expectedCode)
+	}
 }
 
 func captureOutput(f func()) (string, error) {
@@ -65,44 +78,496 @@ func captureOutput(f func()) (string, error) {
 }
 
 func TestMain_Usage(t *testing.T) {
-	e := `Usage: spell [-hlv] <word(s)> 
+	e := `Usage: spell [-hlv] [-advise] [-as] [-except] [-format] [-group] [-index] [-layout] [-numbers] [-only] [-strict] [-width] [-words] <word(s)> 
 
 Options:
+  -advise
+    	Report characters, which are easily confused like 0 and O, and emphasize their kind
+  -as format
+    	Validate and spell word(s) in groups as format: iban, uuid, hex, base32, key, email or url
+  -except classes
+    	Say characters of the comma separated classes as written, like for -only
+  -format format
+    	Output format: text or json (default "text")
+  -group n
+    	Insert a header before every n characters in the numbered layout. 0 disables the headers
   -h	Print this usage note
+  -index n
+    	Mark the position of every nth character in the interlinear layout. 0 disables the marks
   -l alphabet
-    	Spelling alphabet to use (default "en")
+    	Spelling alphabet to use, by language tag like de-DE or by name like NATO. A language tag selects the default alphabet of its language, variants are selected with a private use tag like en-x-maritime. A comma separated list spells with each alphabet (default "en")
+  -layout layout
+    	Text layout: plain, interlinear, which aligns the words under their characters, or numbered, which writes one character per line with its position (default "plain")
+  -numbers numbers
+    	Read numbers as digits, which spells each digit, cardinal, which reads them as numbers of the language, like one hundred twenty-three, or icao, which reads them in radiotelephony, like Flight Level One Eight Zero (default "digits")
+  -only classes
+    	Spell only characters of the comma separated classes: letters, digits, symbols, confusable or non-ascii. Say all others as written
+  -strict
+    	Exit with an error on letters of a different script than their word or the alphabet, like a Cyrillic а in a Latin word, and on look-alikes of the letters of the alphabet, like a fullwidth ａ
   -v	Print version info
+  -width width
+    	Maximal width of lines. Defaults to the terminal width, the environment variable COLUMNS or 80
+  -words
+    	Say the well-known scheme, www and top-level domain of emails and URLs, like https or com, as words
+
+Commands:
+  compare       Compare spelling alphabets key by key
+  decode        Decode word(s) spelled with a spelling alphabet back to text
+  export-sheet  Export a spelling alphabet as printable cheat sheet or flashcards
+  morse         Write word(s) in Morse code
+  quiz          Train a spelling alphabet in timed rounds. Missed characters come back more often
+  show          Show all characters of a spelling alphabet with their words
+
+Run 'spell <command> -h' for the options of a command.
 
 Spelling alphabets:
-  cs    Czech
-  da    Danish
-  de-AT Austrian German, ÖNORM A 1081
-  de-CH Swiss High German
-  de-DE German (Germany), DIN 5009
-  en    English, ICAO, NATO
-  en-GB British English
-  es    Spanish
-  fi    Finnish
-  fr    French
-  it    Italian
-  nl    Dutch
-  no    Norwegian Bokmål
-  pt-BR Brazilian Portuguese
-  pt-PT European Portuguese
-  ro    Romanian
-  ru    Russian
-  sl    Slovenian
-  sv    Swedish
-  tr    Turkish
-  uk    Ukrainian
-`
-	testMain(t, "-h", e)
+  cs                 Czech
+  da                 Danish
+  de-AT              Austrian German, ÖNORM A 1081
+  de-CH              Swiss High German
+  de-DE              German (Germany), DIN 5009
+    de-DE-x-din2022  DIN 5009:2022
+  en                 English, ICAO, NATO
+    en-x-able        Able Baker, Joint Army/Navy
+    en-x-apco        APCO, LAPD
+    en-x-maritime    ITU-maritime, ITU Radio Regulations Appendix 14
+  en-GB              British English
+    en-GB-x-raf1942  RAF 1942
+  es                 Spanish
+  fi                 Finnish
+  fr                 French
+  it                 Italian
+  nl                 Dutch
+  no                 Norwegian Bokmål
+  pl                 Polish
+  pt-BR              Brazilian Portuguese
+  pt-PT              European Portuguese
+  ro                 Romanian
+  ru                 Russian
+  sl                 Slovenian
+  sv                 Swedish
+  tr                 Turkish
+  uk                 Ukrainian
+`
+	testMain(t, e, "-h")
 }
 
 func TestMain_Version(t *testing.T) {
-	testMain(t, "-v", "spell 0.4.0\n")
+	testMain(t, "spell 0.4.0\n", "-v")
 }
 
 func TestMain_Spell(t *testing.T) {
-	testMain(t, "abc", "Alfa Bravo Charlie\n")
+	testMain(t, "Alfa Bravo Charlie\n", "abc")
+}
+
+func TestMain_SpellLangs(t *testing.T) {
+	testMain(t, "en     Alfa Bravo\nde-DE  Anton Berta\nInfo: Guess alphabet 'fr' for input 'fr-CA':\nfr     Anatole Berthe\n",
+		"-l", "en,de,fr-CA", "ab")
+}
+
+func TestMain_SpellEmptyLangs(t *testing.T) {
+	testMain(t, "Warning: Found no spelling alphabet for ''. Using default 'en':\nAlfa Bravo\n", "-l", "", "ab")
+	testMain(t, "Warning: Found no spelling alphabet for ','. Using default 'en':\nAlfa Bravo\n", "-l", ",", "ab")
+}
+
+func TestMain_SpellInterlinear(t *testing.T) {
+	e := `                                 5
+S       c        h      u        l
+Sierra  Charlie  Hotel  Uniform  Lima
+
+                        10
+e     ␣      日    1    2
+Echo  Space  '日'  One  Two
+`
+	testMain(t, e, "-layout", "interlinear", "-width", "40", "-index", "5", "Schule 日12")
+	testMain(t, "en     a     ä\n       Alfa  'ä'\nde-DE  a      ä\n       Anton  Ärger\n", "-l", "en,de", "-layout", "interlinear", "aä")
+	testMainExitCode(t, 1, "Error: Unknown layout 'table'. Use 'plain', 'interlinear' or 'numbered'.\n", "-layout", "table", "ab")
+}
+
+func TestMain_SpellNumbered(t *testing.T) {
+	e := `Group 1: AB1
+1  A  Alfa
+2  B  Bravo
+3  1  One
+
+Group 2: é␣
+4  é  'é'
+5  ␣  Space
+`
+	testMain(t, e, "-layout", "numbered", "-group", "3", "AB1e\u0301 ")
+}
+
+func TestMain_SpellAs(t *testing.T) {
+	e := `GB82  Golf Bravo Eight Two
+WEST  Whiskey Echo Sierra Tango
+1234  One Two Three Four
+`
+	testMain(t, e, "-as", "key", "gb82-west-1234")
+	e = `Warning: The IBAN 'DE88370400440532013000' is invalid: the check digits 88 do not match.
+DE88  Delta Echo Eight Eight
+3704  Three Seven Zero Four
+0044  Zero Zero Four Four
+0532  Zero Five Three Two
+0130  Zero One Three Zero
+00    Zero Zero
+`
+	testMainExitCode(t, 1, e, "-as", "iban", "DE88 3704 0044 0532 0130 00")
+	testMainExitCode(t, 1, "Error: Unknown format 'isbn'. Use 'iban', 'uuid', 'hex', 'base32', 'key', 'email' or 'url'.\n", "-as", "isbn", "1")
+}
+
+func TestMain_SpellAddress(t *testing.T) {
+	e := `jo  Julius Otto
+@   Klammeraffe
+x   Xanthippe
+.   Punkt
+de  Dora Emil
+`
+	testMain(t, e, "-as", "email", "-l", "de", "jo@x.de")
+	e = `https  https
+://    Colon Forward Slash Forward Slash
+x      X-ray
+.      Dot
+com    com
+`
+	testMain(t, e, "-as", "url", "-words", "https://x.com")
+	e = `com  Charlie Oscar Mike
+@    At Sign
+de   Delta Echo
+.    Dot
+at   at
+`
+	testMain(t, e, "-as", "email", "-words", "com@de.at")
+}
+
+func TestMain_SpellHomoglyphs(t *testing.T) {
+	e := `Warning: Character 2 'а' is CYRILLIC SMALL LETTER A (looks like Latin a)
+Papa 'а' Papa Alfa
+`
+	testMain(t, e, "pаpa")
+	testMainExitCode(t, 1, e, "-strict", "pаpa")
+	testMain(t, "Papa Alfa Papa Alfa\n", "-strict", "papa")
+}
+
+func TestMain_SpellAdvise(t *testing.T) {
+	e := `Info: Character 1 'O' can be confused with '0' or 'o'
+Info: Character 2 '0' can be confused with 'O' or 'o'
+Info: Character 4 'l' can be confused with '1', 'I' or '|'
+Oscar (capital letter) Zero (digit) Kilo Lima (small letter)
+`
+	testMain(t, e, "-advise", "O0kl")
+	e = `Info: Character 1 'o' can be confused with '0' or 'O'
+Warning: Found no spelling alphabet for ''. Using default 'en':
+Oscar (small letter)
+`
+	testMain(t, e, "-advise", "-l", "", "o")
+	e = `Info: Character 1 '1' can be confused with 'l', 'I' or '|'
+Info: Character 3 '5' can be confused with 'S' or 's'
+Unaone (digit) Decimal Pantafive (digit)
+`
+	testMain(t, e, "-l", "en-x-maritime", "-advise", "1.5")
+}
+
+func TestMain_SpellOnly(t *testing.T) {
+	testMain(t, "Invoice Four Seven One One\n", "-only", "digits", "Invoice 4711")
+	testMain(t, "A Dash One Space b\n", "-except", "letters", "A-1 b")
+	testMainExitCode(t, 1, "Error: unknown class 'words'. Use 'letters', 'digits', 'symbols', 'confusable' or 'non-ascii'.\n", "-only", "words", "a")
+}
+
+func TestMain_SpellNumbers(t *testing.T) {
+	testMain(t, "einhundertdreiundzwanzig Komma fünf Leerzeichen Emil Ulrich Richard Otto\n", "-l", "de", "-numbers", "cardinal", "123,5 Euro")
+	testMain(t, "quatre-vingt-dix-sept kg\n", "-l", "fr", "-numbers", "cardinal", "-only", "digits", "97 kg")
+	testMain(t, "dwadzieścia jeden tysięcy przecinek pięć\n", "-l", "pl", "-numbers", "cardinal", "-only", "digits", "21\u00a0000,5")
+	testMainExitCode(t, 1, "Error: Unknown numbers 'roman'. Use 'digits', 'cardinal' or 'icao'.\n", "-numbers", "roman", "1")
+}
+
+func TestNumberLanguage(t *testing.T) {
+	for _, a := range alphabet.All {
+		if n, ok := numberLanguage(a); !ok {
+			t.Errorf("numberLanguage(%s) = %s, want number words in the language of the alphabet", a.LangTag(), n.LangTag())
+		}
+	}
+}
+
+func TestMain_SpellICAO(t *testing.T) {
+	testMain(t, "Flight Level Tree Fife Zero Space One One Niner Decimal One\n", "-numbers", "icao", "FL350 119.100")
+	testMain(t, "Tree Tango Zero Zero Space Two Tousand Fife Hundred Space Foxtrot Tango\n", "-numbers", "icao", "3T00 2500 ft")
+}
+
+func TestMain_SpellMaritime(t *testing.T) {
+	testMain(t, "Unaone Soxisix Stop Space Unaone Unaone Oktoeight Decimal Pantafive\n", "-l", "ITU-maritime", "16. 118.5")
+}
+
+func TestMain_SpellVariant(t *testing.T) {
+	testMain(t, "Unaone Decimal Bissotwo\n", "-l", "en-u-va-maritime", "1.2")
+	testMain(t, "One Dot Two\n", "-l", "en", "1.2")
+}
+
+func TestMain_SpellHistorical(t *testing.T) {
+	testMain(t, "Adam Boy Charles\n", "-l", "APCO", "abc")
+	testMain(t, "Able Baker Charlie\n", "-l", "en-x-able", "abc")
+	testMain(t, "Ace Beer Charlie\n", "-l", "en-GB-x-raf1942", "abc")
+}
+
+func TestMain_SpellJSON(t *testing.T) {
+	e := `{
+  "text": "ab",
+  "spellings": [
+    {
+      "lang": "de",
+      "alphabet": "de-DE",
+      "exactness": "exact",
+      "spelled": "Anton Berta"
+    },
+    {
+      "lang": "xx",
+      "alphabet": "en",
+      "exactness": "default",
+      "spelled": "Alfa Bravo"
+    }
+  ]
+}
+`
+	testMain(t, e, "-l", "de,xx", "-format", "json", "ab")
+	e = `{
+  "text": "http://a.com",
+  "spellings": [
+    {
+      "lang": "en",
+      "alphabet": "en",
+      "exactness": "exact",
+      "spelled": "http Colon Forward Slash Forward Slash Alfa Dot com",
+      "groups": [
+        {
+          "text": "http",
+          "spelled": "http"
+        },
+        {
+          "text": "://",
+          "spelled": "Colon Forward Slash Forward Slash"
+        },
+        {
+          "text": "a",
+          "spelled": "Alfa"
+        },
+        {
+          "text": ".",
+          "spelled": "Dot"
+        },
+        {
+          "text": "com",
+          "spelled": "com"
+        }
+      ]
+    }
+  ]
+}
+`
+	testMain(t, e, "-as", "url", "-words", "-format", "json", "http://a.com")
+	testMainExitCode(t, 1, "Error: Unknown format 'csv'. Use 'text' or 'json'.\n", "-format", "csv", "ab")
+}
+
+func TestMain_Compare(t *testing.T) {
+	e := `Key  Status     de-DE      de-AT       de-CH
+A    different  Anton      Anton       Anna
+Ä    different  Ärger      Ärger       Äsch
+CH   different  Charlotte  Charlotte   Chiasso
+D    different  Dora       Dora        Daniel
+J    different  Julius     Julius      Jakob
+K    different  Kaufmann   Konrad      Kaiser
+L    different  Ludwig     Ludwig      Leopold
+M    different  Martha     Martha      Marie
+N    different  Nordpol    Nordpol     Niklaus
+Ö    different  Ökonom     Österreich  Örlikon
+P    different  Paula      Paula       Peter
+Q    different  Quelle     Quelle      Quasi
+R    different  Richard    Richard     Rosa
+S    different  Samuel     Siegfried   Sophie
+SCH  missing    Schule     Schule      -
+ß    missing    Eszett     scharfes S  -
+Ü    different  Übermut    Übel        Übermut
+X    different  Xanthippe  Xaver       Xaver
+Y    different  Ypsilon    Ypsilon     Yverdon
+Z    different  Zacharias  Zürich      Zürich
+
+18 keys differ, 2 keys are missing in some alphabets, 57 keys are the same.
+`
+	testMain(t, e, "compare", "de-DE", "de-AT", "de-CH")
+	testMainExitCode(t, 1, "Error: Unknown format 'csv'. Use 'text' or 'json'.\n", "compare", "-format", "csv", "de", "en")
+}
+
+func TestMain_Decode(t *testing.T) {
+	testMain(t, "AB12\n", "decode", "-l", "en", "Alfa Bravo One Two")
+	testMain(t, "SCH(\n", "decode", "-l", "de", "Schule", "Runde Klammer links")
+	testMainExitCode(t, 1, "Warning: Could not decode word 2 'Bravado'\nAC\n", "decode", "Alfa Bravado Charlie")
+}
+
+func TestMain_DecodeAuto(t *testing.T) {
+	testMain(t, "Info: Detected alphabet 'de-DE' decoding 100% of the words. Runner-ups: 'de-AT' 100%, 'de-CH' 67%, 'nl' 33%:\nABC\n",
+		"decode", "-l", "auto", "Anton Berta Cäsar")
+	testMain(t, "Info: Detected alphabet 'en-GB' decoding 100% of the words:\nAB\n", "decode", "-l", "auto", "Alfred Benjamin")
+	testMainExitCode(t, 1, "Warning: Found no spelling alphabet decoding the input. Using default 'en':\nWarning: Could not decode word 1 'Zzzzzz'\n\n",
+		"decode", "-l", "auto", "Zzzzzz")
+}
+
+func TestMain_DecodeFuzzy(t *testing.T) {
+	testMain(t, "Info: Decoded word 2 'x ray' as 'X' with confidence 0.95\nAX\n", "decode", "-fuzzy", "Alfa x ray")
+	testMain(t, "Info: Decoded word 1 'fine' as '5' with confidence 0.58. Alternatives: '9' (0.58)\n5\n", "decode", "-fuzzy", "fine")
+	testMainExitCode(t, 1, "Warning: Could not decode word 2 'Zzzzzz'\nA\n", "decode", "-fuzzy", "Alfa Zzzzzz")
+}
+
+func TestMain_ExportSheet(t *testing.T) {
+	e := `#separator:tab
+#html:false
+#columns:Front	Back	Tags
+#tags column:3
+A	Adam	spell sv letter
+B	Bertil	spell sv letter
+C	Cesar	spell sv letter
+D	David	spell sv letter
+E	Erik	spell sv letter
+F	Filip	spell sv letter
+G	Gustav	spell sv letter
+H	Helge	spell sv letter
+I	Ivar	spell sv letter
+J	Johan	spell sv letter
+K	Kalle	spell sv letter
+L	Ludvig	spell sv letter
+M	Martin	spell sv letter
+N	Niklas	spell sv letter
+O	Olof	spell sv letter
+P	Petter	spell sv letter
+Q	Qvintus	spell sv letter
+R	Rudolf	spell sv letter
+S	Sigurd	spell sv letter
+T	Tore	spell sv letter
+U	Urban	spell sv letter
+V	Viktor	spell sv letter
+W	Wilhelm	spell sv letter
+X	Xerxes	spell sv letter
+Y	Yngve	spell sv letter
+Ü	Übel	spell sv letter
+Z	Zäta	spell sv letter
+Å	Åke	spell sv letter
+Ä	Ärlig	spell sv letter
+Ö	Östen	spell sv letter
+`
+	testMain(t, e, "export-sheet", "-l", "sv", "--format", "tsv")
+	testMainExitCode(t, 1, "Error: Unknown format 'pdf'. Use 'html', 'svg', 'markdown' or 'tsv'.\n", "export-sheet", "--format", "pdf")
+}
+
+func TestMain_Show(t *testing.T) {
+	e := `italiano (it)
+Status:     informal
+Connector:  come
+Notes:      Most letters are spelled with names of Italian cities.
+
+Letters:
+  A  a  Ancona
+  B  b  Bari
+  C  c  Como
+  D  d  Domodossola
+  E  e  Empoli
+  F  f  Firenze
+  G  g  Genova
+  H  h  Hotel
+  I  i  Imola
+  J  j  Juventus
+  K  k  Kilometro
+  L  l  Livorno
+  M  m  Milano
+  N  n  Napoli
+  O  o  Otranto
+  P  p  Pisa
+  Q  q  Quadro
+  R  r  Roma
+  S  s  Savona
+  T  t  Torino
+  U  u  Udine
+  V  v  Venezia
+  W  w  Vu Doppia
+  X  x  Xilofono
+  Y  y  Ipsilon
+  Z  z  Zara
+`
+	testMain(t, e, "show", "it")
+	testMainExitCode(t, 1, "Error: Unknown format 'xml'. Use 'text', 'json' or 'csv'.\n", "show", "-format", "xml", "it")
+}
+
+func TestMain_ShowMetadata(t *testing.T) {
+	e := `English — Able Baker, Joint Army/Navy (en-x-able)
+Standard:   Joint Army/Navy Phonetic Alphabet (1941)
+Status:     superseded
+Connector:  as in
+Notes:      Used by the US and British armed forces during World War II and replaced by the ICAO alphabet in 1956.
+
+Letters:
+`
+	cleanup := test.ClearCommandLine()
+	defer cleanup()
+	os.Args = append(os.Args, "show", "en-x-able")
+
+	o, err := captureOutput(func() { run() })
+	if err != nil {
+		t.Fatal("Could not capture output of run().", err)
+	}
+	if !strings.HasPrefix(o, e) {
+		t.Errorf("Expected output to start with:\n%s\ngot:\n%s", e, o)
+	}
+}
+
+func TestMain_ShowCSV(t *testing.T) {
+	e := `kind,key,upper,word
+letter,a,A,Anna/Anton
+letter,b,B,Bernard
+letter,c,C,Cornelis
+letter,d,D,Dirk
+letter,e,E,Eduard
+letter,f,F,Ferdinand
+letter,g,G,Gerard
+letter,h,H,Hendrik
+letter,i,I,Izaak
+letter,j,J,Julius
+letter,k,K,Karel
+letter,l,L,Lodewijk
+letter,m,M,Maria
+letter,n,N,Nico
+letter,o,O,Otto
+letter,p,P,Pieter
+letter,q,Q,Quotiënt
+letter,r,R,Richard
+letter,s,S,Simon
+letter,t,T,Theodor
+letter,u,U,Utrecht
+letter,v,V,Victor
+letter,w,W,Willem
+letter,x,X,Xanthippe
+letter,y,Y,Ypsilon
+letter,z,Z,Zaandam
+`
+	testMain(t, e, "show", "-format", "csv", "nl")
+}
+
+func TestMain_Morse(t *testing.T) {
+	testMain(t, "... --- ... / .-.-.\n", "morse", "SOS <AR>")
+	testMain(t, "-- .. .-.\n", "morse", "-l", "ru", "мир")
+	testMain(t, "dit-dah dit-dit-dit-dah\n", "morse", "-style", "words", "av")
+}
+
+func TestMain_MorseWAV(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	wav := filepath.Join(dir, "sos.wav")
+	testMain(t, "... --- ...\n", "morse", "--wav", wav, "--wpm", "25", "sos")
+
+	content, err := ioutil.ReadFile(wav)
+	if err != nil {
+		t.Fatal("Could not read WAV file.", err)
+	}
+	if !bytes.HasPrefix(content, []byte("RIFF")) {
+		t.Error("File", wav, "is no WAV file")
+	}
 }
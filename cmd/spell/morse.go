@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/simonnagl/spell/alphabet"
+	"github.com/simonnagl/spell/morse"
+	"os"
+	"strings"
+)
+
+var morseCommand = command{
+	name:        "morse",
+	args:        "<word(s)>",
+	usage:       "Write word(s) in Morse code",
+	defineFlags: defineMorseFlags,
+	run:         runMorse,
+}
+
+var (
+	morseLang    *string
+	morseStyle   *string
+	morseWAV     *string
+	morseOptions morse.Options
+)
+
+func defineMorseFlags(fs *flag.FlagSet) {
+	morseLang = fs.String("l", "en", "Morse code variant for the script of `language`")
+	morseStyle = fs.String("style", "dots", "Output `style`: dots (.-) or words (dit-dah)")
+	morseWAV = fs.String("wav", "", "Write Morse code as audio to WAV `file`")
+	fs.Float64Var(&morseOptions.WPM, "wpm", morse.DefaultOptions.WPM, "Character speed of audio in words per minute")
+	fs.Float64Var(&morseOptions.FarnsworthWPM, "farnsworth", morse.DefaultOptions.FarnsworthWPM, "Effective speed of audio in words per minute, stretching the spacing (0 disables Farnsworth spacing)")
+	fs.Float64Var(&morseOptions.Frequency, "freq", morse.DefaultOptions.Frequency, "Tone frequency of audio in Hz")
+	fs.IntVar(&morseOptions.SampleRate, "rate", morse.DefaultOptions.SampleRate, "Sample rate of audio in Hz")
+	fs.DurationVar(&morseOptions.Ramp, "ramp", morse.DefaultOptions.Ramp, "Rise and fall `time` of audio tones")
+}
+
+func runMorse(fs *flag.FlagSet) int {
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 0
+	}
+	if *morseStyle != "dots" && *morseStyle != "words" {
+		return errorf("Unknown style '%s'. Use 'dots' or 'words'.", *morseStyle)
+	}
+
+	a, e := alphabet.LookupMorse(*morseLang)
+	if e == alphabet.Default {
+		fmt.Fprintf(os.Stderr, "Warning: Found no Morse code variant for '%s'. Using International Morse code:\n", *morseLang)
+	}
+
+	code := a.Spell(strings.Join(fs.Args(), " "))
+	if *morseWAV != "" {
+		if err := writeMorseWAV(*morseWAV, code); err != nil {
+			return errorf("Could not write WAV file '%s': %v", *morseWAV, err)
+		}
+	}
+
+	if *morseStyle == "words" {
+		code = alphabet.DitDah(code)
+	}
+	fmt.Println(code)
+	return 0
+}
+
+func writeMorseWAV(name string, code string) error {
+	if err := morseOptions.Validate(); err != nil {
+		return err
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err = morse.WriteWAV(w, code, morseOptions); err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
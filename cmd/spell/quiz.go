@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"github.com/simonnagl/spell/alphabet"
+	"github.com/simonnagl/spell/quiz"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+var quizCommand = command{
+	name:        "quiz",
+	args:        "",
+	usage:       "Train a spelling alphabet in timed rounds. Missed characters come back more often",
+	defineFlags: defineQuizFlags,
+	run:         runQuiz,
+}
+
+var (
+	quizLang      *string
+	quizDrill     *string
+	quizRounds    *int
+	quizQuestions *int
+	quizTime      *time.Duration
+	quizState     *string
+)
+
+var quizDrills = map[string]quiz.Drill{
+	"char":  quiz.CharToWord,
+	"word":  quiz.WordToChar,
+	"mixed": quiz.Mixed,
+}
+
+func defineQuizFlags(fs *flag.FlagSet) {
+	quizLang = fs.String("l", "en", "Spelling `alphabet` to train")
+	quizDrill = fs.String("drill", "mixed", "Type of `drill`: char asks for the word of a character, word for the character of a word, mixed for both")
+	quizRounds = fs.Int("rounds", 1, "Number of rounds")
+	quizQuestions = fs.Int("questions", 10, "Maximal number of questions in each round")
+	quizTime = fs.Duration("time", time.Minute, "Time limit of each round. 0 disables the limit")
+	quizState = fs.String("state", "", "State `file` storing the progress (default spell/quiz.json in the user configuration directory)")
+}
+
+// defaultQuizState returns the path of the state file in the user's configuration directory.
+func defaultQuizState() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" && runtime.GOOS == "windows" {
+		dir = os.Getenv("AppData")
+	}
+	if dir == "" {
+		dir = filepath.Join(os.Getenv("HOME"), ".config")
+	}
+	return filepath.Join(dir, "spell", "quiz.json")
+}
+
+func runQuiz(fs *flag.FlagSet) int {
+	drill, ok := quizDrills[*quizDrill]
+	if !ok {
+		return errorf("Unknown drill '%s'. Use 'char', 'word' or 'mixed'.", *quizDrill)
+	}
+
+	if *quizState == "" {
+		*quizState = defaultQuizState()
+	}
+	state, err := quiz.Load(*quizState)
+	if err != nil {
+		return errorf("Could not read state file '%s': %v", *quizState, err)
+	}
+
+	a, e := alphabet.Lookup(*quizLang)
+	printExactness(a, e, *quizLang)
+
+	q := quiz.Quiz{
+		Alphabet:  a,
+		Drill:     drill,
+		Rounds:    *quizRounds,
+		Questions: *quizQuestions,
+		TimeLimit: *quizTime,
+		State:     state,
+		Now:       time.Now,
+		Rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	q.Run(os.Stdin, os.Stdout)
+
+	if err := state.Save(*quizState); err != nil {
+		return errorf("Could not write state file '%s': %v", *quizState, err)
+	}
+	return 0
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/simonnagl/spell/alphabet"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+)
+
+var exportSheetCommand = command{
+	name:        "export-sheet",
+	args:        "",
+	usage:       "Export a spelling alphabet as printable cheat sheet or flashcards",
+	defineFlags: defineExportSheetFlags,
+	run:         runExportSheet,
+}
+
+var (
+	sheetLang   *string
+	sheetFormat *string
+)
+
+// sheetWriters write a sheet in the format of their name.
+var sheetWriters = map[string]func(w io.Writer, s sheet) error{
+	"html":     writeSheetHTML,
+	"markdown": writeSheetMarkdown,
+	"svg":      writeSheetSVG,
+	"tsv":      writeSheetTSV,
+}
+
+func defineExportSheetFlags(fs *flag.FlagSet) {
+	sheetLang = fs.String("l", "en", "Spelling `alphabet` to export")
+	sheetFormat = fs.String("format", "markdown", "Output `format`: html, svg, markdown or tsv (flashcards for Anki)")
+}
+
+func runExportSheet(fs *flag.FlagSet) int {
+	write, ok := sheetWriters[*sheetFormat]
+	if !ok {
+		return errorf("Unknown format '%s'. Use 'html', 'svg', 'markdown' or 'tsv'.", *sheetFormat)
+	}
+
+	a, e := alphabet.Lookup(*sheetLang)
+	printExactness(a, e, *sheetLang)
+
+	w := bufio.NewWriter(os.Stdout)
+	err := write(w, newSheet(a))
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		return errorf("Could not write sheet: %v", err)
+	}
+	return 0
+}
+
+// sheet is a spelling alphabet prepared for export.
+type sheet struct {
+	// Lang is the BCP 47 language tag of the alphabet.
+	Lang string
+	// Title names the alphabet in its own language together with its standards, like "Deutsch — DIN 5009".
+	Title    string
+	Sections []sheetSection
+}
+
+// sheetSection lists the entries of one kind, like all letters.
+type sheetSection struct {
+	Title   string
+	Kind    alphabet.Kind
+	Entries []sheetEntry
+}
+
+type sheetEntry struct {
+	// Char is the key in upper case. A space is shown as "␣".
+	Char string
+	Word string
+}
+
+var sheetSectionTitles = map[alphabet.Kind]string{
+	alphabet.Letter: "Letters",
+	alphabet.Digit:  "Digits",
+	alphabet.Symbol: "Symbols",
+}
+
+func newSheet(a alphabet.SpellingAlphabet) sheet {
+	s := sheet{Lang: a.LangTag(), Title: alphabetTitle(a)}
+
+	for _, e := range a.Entries() {
+		if len(s.Sections) == 0 || s.Sections[len(s.Sections)-1].Kind != e.Kind {
+			s.Sections = append(s.Sections, sheetSection{Title: sheetSectionTitles[e.Kind], Kind: e.Kind})
+		}
+		section := &s.Sections[len(s.Sections)-1]
+		section.Entries = append(section.Entries, sheetEntry{visible(e.Upper), e.Word})
+	}
+	return s
+}
+
+var sheetHTML = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; }
+section { display: inline-block; vertical-align: top; margin: 0 2em 1em 0; break-inside: avoid; }
+table { border-collapse: collapse; }
+td { border: 1px solid #999; padding: 0.2em 0.6em; }
+td.char { font-weight: bold; text-align: center; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Sections}}<section>
+<h2>{{.Title}}</h2>
+<table>
+{{range .Entries}}<tr><td class="char">{{.Char}}</td><td>{{.Word}}</td></tr>
+{{end}}</table>
+</section>
+{{end}}</body>
+</html>
+`))
+
+func writeSheetHTML(w io.Writer, s sheet) error {
+	return sheetHTML.Execute(w, s)
+}
+
+// markdownEscaper escapes characters with a meaning in Markdown tables.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`)
+
+func writeSheetMarkdown(w io.Writer, s sheet) error {
+	if _, err := fmt.Fprintf(w, "# %s\n", markdownEscaper.Replace(s.Title)); err != nil {
+		return err
+	}
+	for _, section := range s.Sections {
+		if _, err := fmt.Fprintf(w, "\n## %s\n\n| Character | Word |\n|:-:|---|\n", section.Title); err != nil {
+			return err
+		}
+		for _, e := range section.Entries {
+			if _, err := fmt.Fprintf(w, "| %s | %s |\n", markdownEscaper.Replace(e.Char), markdownEscaper.Replace(e.Word)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Layout of the SVG sheet in pixels.
+const (
+	svgMargin     = 24
+	svgLineHeight = 24
+	svgWordX      = 80
+	svgWidth      = 480
+)
+
+// svgLine is a line of text in the SVG sheet at height Y. Lines of a heading have no Word.
+type svgLine struct {
+	Y       int
+	Heading bool
+	Char    string
+	Word    string
+}
+
+var sheetSVG = template.Must(template.New("svg").Parse(`<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="{{.Height}}" font-family="sans-serif" font-size="16">
+<rect width="100%" height="100%" fill="white"/>
+<text x="{{.Margin}}" y="{{.Margin}}" font-size="24" font-weight="bold">{{.Title}}</text>
+{{range .Lines}}{{if .Heading}}<text x="{{$.Margin}}" y="{{.Y}}" font-size="20" font-weight="bold">{{.Char}}</text>
+{{else}}<text x="{{$.Margin}}" y="{{.Y}}" font-weight="bold">{{.Char}}</text><text x="{{$.WordX}}" y="{{.Y}}">{{.Word}}</text>
+{{end}}{{end}}</svg>
+`))
+
+func writeSheetSVG(w io.Writer, s sheet) error {
+	var lines []svgLine
+	y := svgMargin
+	for _, section := range s.Sections {
+		y += 2 * svgLineHeight
+		lines = append(lines, svgLine{Y: y, Heading: true, Char: section.Title})
+		for _, e := range section.Entries {
+			y += svgLineHeight
+			lines = append(lines, svgLine{Y: y, Char: e.Char, Word: e.Word})
+		}
+	}
+
+	return sheetSVG.Execute(w, struct {
+		Title                        string
+		Lines                        []svgLine
+		Margin, WordX, Width, Height int
+	}{s.Title, lines, svgMargin, svgWordX + svgMargin, svgWidth, y + svgMargin})
+}
+
+// writeSheetTSV writes flashcards with the character on the front and the word on the back.
+// The header lines configure the import of Anki. Each card is tagged with the language tag and kind of its character.
+func writeSheetTSV(w io.Writer, s sheet) error {
+	if _, err := fmt.Fprint(w, "#separator:tab\n#html:false\n#columns:Front\tBack\tTags\n#tags column:3\n"); err != nil {
+		return err
+	}
+	for _, section := range s.Sections {
+		tags := fmt.Sprintf("spell %s %s", s.Lang, strings.ToLower(section.Kind.String()))
+		for _, e := range section.Entries {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", e.Char, e.Word, tags); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
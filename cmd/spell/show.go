@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/simonnagl/spell/alphabet"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+var showCommand = command{
+	name:        "show",
+	args:        "<alphabet>",
+	usage:       "Show all characters of a spelling alphabet with their words",
+	defineFlags: defineShowFlags,
+	run:         runShow,
+}
+
+var showFormat *string
+
+func defineShowFlags(fs *flag.FlagSet) {
+	showFormat = fs.String("format", "text", "Output `format`: text, json or csv")
+}
+
+func runShow(fs *flag.FlagSet) int {
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 0
+	}
+	if *showFormat != "text" && *showFormat != "json" && *showFormat != "csv" {
+		return errorf("Unknown format '%s'. Use 'text', 'json' or 'csv'.", *showFormat)
+	}
+
+	lang := fs.Arg(0)
+	a, e := alphabet.Lookup(lang)
+	printExactness(a, e, lang)
+
+	var err error
+	switch *showFormat {
+	case "json":
+		err = showJSON(a)
+	case "csv":
+		err = showCSV(a)
+	default:
+		err = showText(a)
+	}
+	if err != nil {
+		return errorf("Could not show alphabet: %v", err)
+	}
+	return 0
+}
+
+// showText prints the entries of a as table, with one section for letters, digits and symbols.
+func showText(a alphabet.SpellingAlphabet) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s (%s)\n", alphabetTitle(a), a.LangTag())
+	printMetadata(w, a.Metadata())
+
+	var kind alphabet.Kind
+	for i, e := range a.Entries() {
+		if i == 0 || e.Kind != kind {
+			kind = e.Kind
+			fmt.Fprintf(w, "\n%s:\n", sheetSectionTitles[kind])
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", visible(e.Upper), visible(e.Key), e.Word)
+	}
+	return w.Flush()
+}
+
+// printMetadata prints the fields of m, which are set, one per line. The status is always printed.
+func printMetadata(w io.Writer, m alphabet.Metadata) {
+	fields := []struct{ name, value string }{
+		{"Standard", m.Reference()},
+		{"Source", m.Source},
+		{"Status", strings.ToLower(m.Status.String())},
+		{"Connector", m.Connector},
+		{"Notes", m.Notes},
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			fmt.Fprintf(w, "%s:\t%s\n", f.name, f.value)
+		}
+	}
+}
+
+func showJSON(a alphabet.SpellingAlphabet) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Lang     string            `json:"lang"`
+		Name     string            `json:"name"`
+		Names    []string          `json:"names,omitempty"`
+		Metadata alphabet.Metadata `json:"metadata"`
+		Entries  []alphabet.Entry  `json:"entries"`
+	}{a.LangTag(), a.LangSelfName(), a.Names(), a.Metadata(), a.Entries()})
+}
+
+func showCSV(a alphabet.SpellingAlphabet) error {
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write([]string{"kind", "key", "upper", "word"})
+	for _, e := range a.Entries() {
+		kind, _ := e.Kind.MarshalText()
+		_ = w.Write([]string{string(kind), e.Key, e.Upper, e.Word})
+	}
+	w.Flush()
+	return w.Error()
+}
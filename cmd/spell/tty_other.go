@@ -0,0 +1,9 @@
+//go:build !darwin && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!freebsd,!linux,!netbsd,!openbsd
+
+package main
+
+// ttyColumns returns 0, because the size of terminals is only known on Unix.
+func ttyColumns(fd uintptr) int {
+	return 0
+}
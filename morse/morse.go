@@ -0,0 +1,128 @@
+// Package morse synthesizes Morse code as audio. Clients should not use this internal package, used by github.com/simonnagl/spell/cmd/spell.
+//
+// The Morse code is read in the form written by the Morse code variants of github.com/simonnagl/spell/alphabet:
+// Characters are written in dots and dashes and separated by a space. Words are separated by " / ".
+package morse
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Options configure the timing and the tone of synthesized Morse code.
+type Options struct {
+	// WPM is the character speed in words per minute, measured with the word "PARIS".
+	WPM float64
+	// FarnsworthWPM is the effective speed in words per minute.
+	// If it is below WPM, the spacing between characters and words is stretched to reach it.
+	// Zero disables Farnsworth spacing.
+	FarnsworthWPM float64
+	// Frequency of the tone in Hz.
+	Frequency float64
+	// SampleRate in samples per second.
+	SampleRate int
+	// Ramp is the rise and fall time of each tone, which avoids key clicks.
+	Ramp time.Duration
+}
+
+// DefaultOptions are commonly used options for practising Morse code.
+var DefaultOptions = Options{
+	WPM:        20,
+	Frequency:  600,
+	SampleRate: 8000,
+	Ramp:       5 * time.Millisecond,
+}
+
+// Validate returns an error, if o can not be used to synthesize Morse code.
+func (o Options) Validate() error {
+	switch {
+	case o.WPM <= 0:
+		return errors.New("words per minute must be positive")
+	case o.FarnsworthWPM < 0:
+		return errors.New("Farnsworth words per minute must not be negative")
+	case o.SampleRate <= 0:
+		return errors.New("sample rate must be positive")
+	case o.Frequency <= 0 || o.Frequency >= float64(o.SampleRate)/2:
+		return fmt.Errorf("frequency must be between 0 and %v Hz for a sample rate of %v", o.SampleRate/2, o.SampleRate)
+	case o.Ramp < 0 || 2*o.Ramp > o.unit():
+		return fmt.Errorf("ramp must be between 0 and %v at %v words per minute", o.unit()/2, o.WPM)
+	}
+	return nil
+}
+
+// unit returns the duration of a dit.
+func (o Options) unit() time.Duration {
+	return seconds(1.2 / o.WPM)
+}
+
+// gaps returns the spacing between characters and between words.
+func (o Options) gaps() (character time.Duration, word time.Duration) {
+	if o.FarnsworthWPM <= 0 || o.FarnsworthWPM >= o.WPM {
+		return 3 * o.unit(), 7 * o.unit()
+	}
+	// The word "PARIS" has 31 units of elements and 19 units of spacing between characters and words.
+	// Farnsworth spacing distributes the time missing for the effective speed to these 19 units.
+	delay := (60*o.WPM - 37.2*o.FarnsworthWPM) / (o.WPM * o.FarnsworthWPM)
+	return seconds(3 * delay / 19), seconds(7 * delay / 19)
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Round(s * float64(time.Second)))
+}
+
+// Element is a period of Morse code, in which the tone is either on or off.
+type Element struct {
+	On       bool
+	Duration time.Duration
+}
+
+// Elements returns the timing of code.
+//
+// Everything in code which is no Morse code, like unmapped characters, is skipped.
+// The returned Elements start and end with a tone.
+func (o Options) Elements(code string) []Element {
+	unit := o.unit()
+	charGap, wordGap := o.gaps()
+
+	var elements []Element
+	var gap time.Duration
+	for _, field := range strings.Fields(code) {
+		if field == "/" {
+			if gap < wordGap {
+				gap = wordGap
+			}
+			continue
+		}
+		if !isCode(field) {
+			continue
+		}
+
+		if len(elements) > 0 {
+			elements = append(elements, Element{false, gap})
+		}
+		for i, sign := range field {
+			if i > 0 {
+				elements = append(elements, Element{false, unit})
+			}
+			if sign == '.' {
+				elements = append(elements, Element{true, unit})
+			} else {
+				elements = append(elements, Element{true, 3 * unit})
+			}
+		}
+		gap = charGap
+	}
+	return elements
+}
+
+func isCode(s string) bool {
+	for _, r := range s {
+		if r != '.' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
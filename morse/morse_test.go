@@ -0,0 +1,154 @@
+package morse
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestOptions_Elements(t *testing.T) {
+	o := Options{WPM: 12}
+	dit := 100 * time.Millisecond
+	want := []Element{
+		{true, dit}, {false, dit}, {true, 3 * dit},
+		{false, 3 * dit},
+		{true, dit},
+		{false, 7 * dit},
+		{true, 3 * dit},
+	}
+
+	got := o.Elements(".- '€' . / -")
+	if len(got) != len(want) {
+		t.Fatalf("Elements() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Elements()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestOptions_ElementsFarnsworth(t *testing.T) {
+	o := Options{WPM: 20, FarnsworthWPM: 10}
+	paris := o.Elements(".--. .- .-. .. ... /")
+	var total time.Duration
+	for _, e := range paris {
+		total += e.Duration
+	}
+	_, wordGap := o.gaps()
+	total += wordGap
+
+	if want := 6 * time.Second; absDuration(total-want) > time.Millisecond {
+		t.Errorf("PARIS at an effective speed of 10 WPM took %v, want %v", total, want)
+	}
+}
+
+func TestOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		o       Options
+		wantErr bool
+	}{
+		{"default", DefaultOptions, false},
+		{"no speed", Options{Frequency: 600, SampleRate: 8000}, true},
+		{"aliasing", Options{WPM: 20, Frequency: 4000, SampleRate: 8000}, true},
+		{"long ramp", Options{WPM: 20, Frequency: 600, SampleRate: 8000, Ramp: 40 * time.Millisecond}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.o.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteWAV(t *testing.T) {
+	for _, o := range []Options{
+		DefaultOptions,
+		{WPM: 25, FarnsworthWPM: 15, Frequency: 700, SampleRate: 44100, Ramp: 4 * time.Millisecond},
+	} {
+		code := ".--. .- .-. .. ... / -- --- .-. ... ."
+		var buf bytes.Buffer
+		if err := WriteWAV(&buf, code, o); err != nil {
+			t.Fatal(err)
+		}
+
+		var header wavHeader
+		if err := binary.Read(&buf, binary.LittleEndian, &header); err != nil {
+			t.Fatal(err)
+		}
+		if string(header.ChunkID[:]) != "RIFF" || string(header.Format[:]) != "WAVE" {
+			t.Errorf("WAV header %v is no RIFF WAVE header", header)
+		}
+		if int(header.SampleRate) != o.SampleRate || header.Subchunk2Size != uint32(buf.Len()) {
+			t.Errorf("WAV header %v does not match sample rate %v and data size %v", header, o.SampleRate, buf.Len())
+		}
+
+		samples := make([]int16, buf.Len()/2)
+		if err := binary.Read(&buf, binary.LittleEndian, samples); err != nil {
+			t.Fatal(err)
+		}
+
+		got := decodeEnvelope(samples, o)
+		want := o.Elements(code)
+		if len(got) != len(want) {
+			t.Fatalf("Decoded %d elements, want %d", len(got), len(want))
+		}
+		// The ramps shorten the detected tones. One period of the tone is needed to detect a change.
+		tolerance := o.Ramp + time.Duration(float64(time.Second)/o.Frequency)
+		for i := range want {
+			if got[i].On != want[i].On || absDuration(got[i].Duration-want[i].Duration) > tolerance {
+				t.Errorf("Decoded element %d = %v, want %v", i, got[i], want[i])
+			}
+		}
+	}
+}
+
+// decodeEnvelope detects the periods of tone and silence in samples.
+func decodeEnvelope(samples []int16, o Options) []Element {
+	period := int(float64(o.SampleRate) / o.Frequency)
+	threshold := amplitude * math.MaxInt16 / 2
+
+	var elements []Element
+	runStart := 0
+	for i := range samples {
+		on := false
+		for j := i - period/2; j <= i+period/2; j++ {
+			if 0 <= j && j < len(samples) && math.Abs(float64(samples[j])) > threshold {
+				on = true
+				break
+			}
+		}
+		if len(elements) == 0 || elements[len(elements)-1].On != on {
+			if len(elements) > 0 {
+				elements[len(elements)-1].Duration = duration(i-runStart, o.SampleRate)
+			}
+			elements = append(elements, Element{On: on})
+			runStart = i
+		}
+	}
+	elements[len(elements)-1].Duration = duration(len(samples)-runStart, o.SampleRate)
+
+	// The ramps start and end with silence.
+	if !elements[0].On {
+		elements = elements[1:]
+	}
+	if !elements[len(elements)-1].On {
+		elements = elements[:len(elements)-1]
+	}
+	return elements
+}
+
+func duration(samples int, sampleRate int) time.Duration {
+	return time.Duration(float64(samples) / float64(sampleRate) * float64(time.Second))
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,110 @@
+package morse
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+)
+
+// amplitude of the tone relative to the maximum of a 16 bit sample.
+const amplitude = 0.8
+
+// wavHeader is the header of a mono 16 bit PCM WAV file.
+type wavHeader struct {
+	ChunkID       [4]byte
+	ChunkSize     uint32
+	Format        [4]byte
+	Subchunk1ID   [4]byte
+	Subchunk1Size uint32
+	AudioFormat   uint16
+	NumChannels   uint16
+	SampleRate    uint32
+	ByteRate      uint32
+	BlockAlign    uint16
+	BitsPerSample uint16
+	Subchunk2ID   [4]byte
+	Subchunk2Size uint32
+}
+
+// WriteWAV writes code as mono 16 bit PCM WAV audio to w.
+func WriteWAV(w io.Writer, code string, o Options) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	samples := Synthesize(code, o)
+	dataSize := uint32(2 * len(samples))
+	header := wavHeader{
+		ChunkID:       [4]byte{'R', 'I', 'F', 'F'},
+		ChunkSize:     36 + dataSize,
+		Format:        [4]byte{'W', 'A', 'V', 'E'},
+		Subchunk1ID:   [4]byte{'f', 'm', 't', ' '},
+		Subchunk1Size: 16,
+		AudioFormat:   1,
+		NumChannels:   1,
+		SampleRate:    uint32(o.SampleRate),
+		ByteRate:      uint32(2 * o.SampleRate),
+		BlockAlign:    2,
+		BitsPerSample: 16,
+		Subchunk2ID:   [4]byte{'d', 'a', 't', 'a'},
+		Subchunk2Size: dataSize,
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, header); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, samples)
+}
+
+// Synthesize returns the 16 bit PCM samples of code.
+//
+// Each tone rises and falls with a raised cosine shape during o.Ramp.
+// The position of each Element is rounded to the nearest sample, so timing errors do not add up.
+func Synthesize(code string, o Options) []int16 {
+	elements := o.Elements(code)
+
+	var total time.Duration
+	for _, e := range elements {
+		total += e.Duration
+	}
+	samples := make([]int16, sampleIndex(total, o.SampleRate))
+
+	rampLen := sampleIndex(o.Ramp, o.SampleRate)
+	var start time.Duration
+	for _, e := range elements {
+		end := start + e.Duration
+		if e.On {
+			tone(samples[sampleIndex(start, o.SampleRate):sampleIndex(end, o.SampleRate)], o, rampLen)
+		}
+		start = end
+	}
+	return samples
+}
+
+func sampleIndex(d time.Duration, sampleRate int) int {
+	return int(math.Round(d.Seconds() * float64(sampleRate)))
+}
+
+// tone writes a sine tone with rise and fall ramps of rampLen samples to samples.
+func tone(samples []int16, o Options, rampLen int) {
+	n := len(samples)
+	if 2*rampLen > n {
+		rampLen = n / 2
+	}
+	for i := range samples {
+		gain := 1.0
+		if i < rampLen {
+			gain = rampGain(i, rampLen)
+		} else if n-1-i < rampLen {
+			gain = rampGain(n-1-i, rampLen)
+		}
+		phase := 2 * math.Pi * o.Frequency * float64(i) / float64(o.SampleRate)
+		samples[i] = int16(amplitude * gain * math.MaxInt16 * math.Sin(phase))
+	}
+}
+
+// rampGain returns the raised cosine gain of sample i of a ramp with rampLen samples.
+func rampGain(i int, rampLen int) float64 {
+	return 0.5 * (1 - math.Cos(math.Pi*float64(i)/float64(rampLen)))
+}
@@ -0,0 +1,111 @@
+package numeral
+
+import (
+	"strings"
+)
+
+var (
+	trOnes   = []string{"sıfır", "bir", "iki", "üç", "dört", "beş", "altı", "yedi", "sekiz", "dokuz"}
+	trTens   = []string{"", "on", "yirmi", "otuz", "kırk", "elli", "altmış", "yetmiş", "seksen", "doksan"}
+	trScales = []string{"", "bin", "milyon", "milyar", "trilyon"}
+)
+
+// turkish reads numbers in Turkish, which counts a single hundred and thousand without "bir", like "bin yüz".
+func turkish(n uint64) string {
+	if n == 0 {
+		return trOnes[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 0; i-- {
+		if g[i] == 0 {
+			continue
+		}
+		if g[i] > 1 || i != 1 {
+			words = append(words, trHundreds(g[i]))
+		}
+		if i > 0 {
+			words = append(words, trScales[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func trHundreds(n int) string {
+	var words []string
+	switch h := n / 100; h {
+	case 0:
+	case 1:
+		words = append(words, "yüz")
+	default:
+		words = append(words, trOnes[h], "yüz")
+	}
+	if t := n / 10 % 10; t > 0 {
+		words = append(words, trTens[t])
+	}
+	if u := n % 10; u > 0 {
+		words = append(words, trOnes[u])
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	fiOnes = []string{"nolla", "yksi", "kaksi", "kolme", "neljä", "viisi", "kuusi", "seitsemän", "kahdeksan", "yhdeksän",
+		"kymmenen"}
+	// fiScales are the singular and partitive of the scales from a million on.
+	fiScales = [][2]string{{}, {}, {"miljoona", "miljoonaa"}, {"miljardi", "miljardia"}, {"biljoona", "biljoonaa"}}
+)
+
+// finnish reads numbers in Finnish. Numbers below a million are written in one word and counted scales take the
+// partitive, like "kaksituhatta".
+func finnish(n uint64) string {
+	if n == 0 {
+		return fiOnes[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 2; i-- {
+		switch g[i] {
+		case 0:
+		case 1:
+			words = append(words, fiScales[i][0])
+		default:
+			words = append(words, fiHundreds(g[i]), fiScales[i][1])
+		}
+	}
+	if below := g[1]*1000 + g[0]; below > 0 {
+		var word string
+		switch g[1] {
+		case 0:
+		case 1:
+			word = "tuhat"
+		default:
+			word = fiHundreds(g[1]) + "tuhatta"
+		}
+		words = append(words, word+fiHundreds(g[0]))
+	}
+	return strings.Join(words, " ")
+}
+
+func fiHundreds(n int) string {
+	var s string
+	switch h := n / 100; h {
+	case 0:
+	case 1:
+		s = "sata"
+	default:
+		s = fiOnes[h] + "sataa"
+	}
+	switch n %= 100; {
+	case n > 10 && n < 20:
+		s += fiOnes[n%10] + "toista"
+	case n >= 20:
+		s += fiOnes[n/10] + "kymmentä"
+		if n%10 > 0 {
+			s += fiOnes[n%10]
+		}
+	case n > 0:
+		s += fiOnes[n]
+	}
+	return s
+}
@@ -0,0 +1,26 @@
+package numeral
+
+import (
+	"testing"
+)
+
+func TestTurkish(t *testing.T) {
+	testCardinal(t, "tr", map[uint64]string{
+		100:     "yüz",
+		123:     "yüz yirmi üç",
+		1000:    "bin",
+		2000:    "iki bin",
+		1000000: "bir milyon",
+	})
+}
+
+func TestFinnish(t *testing.T) {
+	testCardinal(t, "fi", map[uint64]string{
+		11:      "yksitoista",
+		21:      "kaksikymmentäyksi",
+		1234:    "tuhatkaksisataakolmekymmentäneljä",
+		2000:    "kaksituhatta",
+		1000000: "miljoona",
+		2000000: "kaksi miljoonaa",
+	})
+}
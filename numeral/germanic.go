@@ -0,0 +1,342 @@
+package numeral
+
+import (
+	"strings"
+)
+
+var (
+	enOnes = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "eleven",
+		"twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	enTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	enScales = []string{"", "thousand", "million", "billion", "trillion"}
+)
+
+// english reads numbers in English, like "one hundred twenty-three".
+// British English says "and" before the tens, like "one hundred and twenty-three".
+func english(and bool) func(n uint64) string {
+	return func(n uint64) string {
+		if n == 0 {
+			return enOnes[0]
+		}
+		g := groups(n)
+		var words []string
+		for i := len(g) - 1; i >= 0; i-- {
+			if g[i] == 0 {
+				continue
+			}
+			if and && i == 0 && g[0] < 100 && n >= 1000 {
+				words = append(words, "and")
+			}
+			words = append(words, enHundreds(g[i], and))
+			if i > 0 {
+				words = append(words, enScales[i])
+			}
+		}
+		return strings.Join(words, " ")
+	}
+}
+
+func enHundreds(n int, and bool) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, enOnes[n/100], "hundred")
+		if n %= 100; n > 0 && and {
+			words = append(words, "and")
+		}
+	}
+	switch {
+	case n >= 20 && n%10 > 0:
+		words = append(words, enTens[n/10]+"-"+enOnes[n%10])
+	case n >= 20:
+		words = append(words, enTens[n/10])
+	case n > 0:
+		words = append(words, enOnes[n])
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	deOnes = []string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn", "elf",
+		"zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	deTens = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+	// deScales are the singular and plural of the scales from a million on.
+	deScales = [][2]string{{}, {}, {"Million", "Millionen"}, {"Milliarde", "Milliarden"}, {"Billion", "Billionen"}}
+)
+
+// german reads numbers in German. Numbers below a million are written in one word, like "eintausendzweihundertvierunddreißig".
+// Swiss Standard German writes "ss" instead of "ß", like "dreissig".
+func german(swiss bool) func(n uint64) string {
+	return func(n uint64) string {
+		if n == 0 {
+			return deOnes[0]
+		}
+		g := groups(n)
+		var words []string
+		for i := len(g) - 1; i >= 2; i-- {
+			switch g[i] {
+			case 0:
+			case 1:
+				words = append(words, "eine", deScales[i][0])
+			default:
+				words = append(words, deHundreds(g[i], false), deScales[i][1])
+			}
+		}
+		if below := g[1]*1000 + g[0]; below > 0 {
+			var word string
+			if g[1] > 0 {
+				word = deHundreds(g[1], false) + "tausend"
+			}
+			words = append(words, word+deHundreds(g[0], true))
+		}
+
+		s := strings.Join(words, " ")
+		if swiss {
+			s = strings.Replace(s, "ß", "ss", -1)
+		}
+		return s
+	}
+}
+
+// deHundreds reads n below a thousand. A final one is "eins", if it ends the number, and "ein" otherwise.
+func deHundreds(n int, final bool) string {
+	var s string
+	if n >= 100 {
+		s = deUnit(n/100) + "hundert"
+		n %= 100
+	}
+	switch {
+	case n == 1 && final:
+		s += deOnes[1]
+	case n == 1:
+		s += deUnit(1)
+	case n >= 20 && n%10 > 0:
+		s += deUnit(n%10) + "und" + deTens[n/10]
+	case n >= 20:
+		s += deTens[n/10]
+	case n > 0:
+		s += deOnes[n]
+	}
+	return s
+}
+
+// deUnit reads a digit in a compound, like the "ein" of "einundzwanzig".
+func deUnit(n int) string {
+	if n == 1 {
+		return "ein"
+	}
+	return deOnes[n]
+}
+
+var (
+	nlOnes = []string{"nul", "één", "twee", "drie", "vier", "vijf", "zes", "zeven", "acht", "negen", "tien", "elf",
+		"twaalf", "dertien", "veertien", "vijftien", "zestien", "zeventien", "achttien", "negentien"}
+	nlTens   = []string{"", "", "twintig", "dertig", "veertig", "vijftig", "zestig", "zeventig", "tachtig", "negentig"}
+	nlScales = []string{"", "", "miljoen", "miljard", "biljoen"}
+)
+
+// dutch reads numbers in Dutch. Numbers below a thousand are written in one word, like "honderddrieëntwintig".
+func dutch(n uint64) string {
+	if n < 2 {
+		return nlOnes[n]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 2; i-- {
+		if g[i] > 0 {
+			words = append(words, nlHundreds(g[i], true), nlScales[i])
+		}
+	}
+	switch g[1] {
+	case 0:
+	case 1:
+		words = append(words, "duizend")
+	default:
+		words = append(words, nlHundreds(g[1], false)+"duizend")
+	}
+	if g[0] > 0 {
+		words = append(words, nlHundreds(g[0], false))
+	}
+	return strings.Join(words, " ")
+}
+
+// nlHundreds reads n below a thousand. A single one is "een", if it counts a scale, like "een miljoen".
+func nlHundreds(n int, scale bool) string {
+	var s string
+	switch {
+	case n == 1 && scale:
+		return "een"
+	case n >= 200:
+		s = nlUnit(n/100) + "honderd"
+	case n >= 100:
+		s = "honderd"
+	}
+	n %= 100
+	switch {
+	case n >= 20 && n%10 > 0:
+		unit := nlUnit(n % 10)
+		if strings.HasSuffix(unit, "e") {
+			s += unit + "ën" + nlTens[n/10]
+		} else {
+			s += unit + "en" + nlTens[n/10]
+		}
+	case n >= 20:
+		s += nlTens[n/10]
+	case n > 0:
+		s += nlUnit(n)
+	}
+	return s
+}
+
+// nlUnit reads a number below twenty in a compound, where one is written without accents.
+func nlUnit(n int) string {
+	if n == 1 {
+		return "een"
+	}
+	return nlOnes[n]
+}
+
+// nordic are the number words of Danish and Norwegian, which write numbers in separate words joined by "og", like
+// "et hundrede og treogtyve".
+type nordic struct {
+	ones, tens []string
+	// unitsFirst reads the units before the tens, like "treogtyve".
+	unitsFirst bool
+	// one is the neuter one, which counts hundreds and thousands.
+	one               string
+	hundred, thousand string
+	// scales are the singular and plural of the scales from a million on.
+	scales [][2]string
+}
+
+var danish = nordic{
+	ones: []string{"nul", "en", "to", "tre", "fire", "fem", "seks", "syv", "otte", "ni", "ti", "elleve", "tolv", "tretten",
+		"fjorten", "femten", "seksten", "sytten", "atten", "nitten"},
+	tens:       []string{"", "", "tyve", "tredive", "fyrre", "halvtreds", "tres", "halvfjerds", "firs", "halvfems"},
+	unitsFirst: true,
+	one:        "et",
+	hundred:    "hundrede",
+	thousand:   "tusind",
+	scales:     [][2]string{{}, {}, {"million", "millioner"}, {"milliard", "milliarder"}, {"billion", "billioner"}},
+}
+
+var norwegian = nordic{
+	ones: []string{"null", "en", "to", "tre", "fire", "fem", "seks", "sju", "åtte", "ni", "ti", "elleve", "tolv", "tretten",
+		"fjorten", "femten", "seksten", "sytten", "atten", "nitten"},
+	tens:     []string{"", "", "tjue", "tretti", "førti", "femti", "seksti", "sytti", "åtti", "nitti"},
+	one:      "ett",
+	hundred:  "hundre",
+	thousand: "tusen",
+	scales:   [][2]string{{}, {}, {"million", "millioner"}, {"milliard", "milliarder"}, {"billion", "billioner"}},
+}
+
+func (l nordic) cardinal(n uint64) string {
+	if n == 0 {
+		return l.ones[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 2; i-- {
+		switch g[i] {
+		case 0:
+		case 1:
+			words = append(words, l.ones[1], l.scales[i][0])
+		default:
+			words = append(words, l.hundreds(g[i]), l.scales[i][1])
+		}
+	}
+	switch g[1] {
+	case 0:
+	case 1:
+		words = append(words, l.one, l.thousand)
+	default:
+		words = append(words, l.hundreds(g[1]), l.thousand)
+	}
+	if g[0] > 0 {
+		if g[0] < 100 && n >= 1000 {
+			words = append(words, "og")
+		}
+		words = append(words, l.hundreds(g[0]))
+	}
+	return strings.Join(words, " ")
+}
+
+func (l nordic) hundreds(n int) string {
+	var words []string
+	if n >= 100 {
+		if n/100 == 1 {
+			words = append(words, l.one, l.hundred)
+		} else {
+			words = append(words, l.ones[n/100], l.hundred)
+		}
+		if n %= 100; n > 0 {
+			words = append(words, "og")
+		}
+	}
+	switch {
+	case n >= 20 && n%10 > 0 && l.unitsFirst:
+		words = append(words, l.ones[n%10]+"og"+l.tens[n/10])
+	case n >= 20 && n%10 > 0:
+		words = append(words, l.tens[n/10]+l.ones[n%10])
+	case n >= 20:
+		words = append(words, l.tens[n/10])
+	case n > 0:
+		words = append(words, l.ones[n])
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	svOnes = []string{"noll", "ett", "två", "tre", "fyra", "fem", "sex", "sju", "åtta", "nio", "tio", "elva", "tolv",
+		"tretton", "fjorton", "femton", "sexton", "sjutton", "arton", "nitton"}
+	svTens = []string{"", "", "tjugo", "trettio", "fyrtio", "femtio", "sextio", "sjuttio", "åttio", "nittio"}
+	// svScales are the singular and plural of the scales from a million on.
+	svScales = [][2]string{{}, {}, {"miljon", "miljoner"}, {"miljard", "miljarder"}, {"biljon", "biljoner"}}
+)
+
+// swedish reads numbers in Swedish. Numbers below a million are written in one word, like "ettusentvåhundra".
+func swedish(n uint64) string {
+	if n == 0 {
+		return svOnes[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 2; i-- {
+		switch g[i] {
+		case 0:
+		case 1:
+			words = append(words, "en", svScales[i][0])
+		default:
+			// The scales are common gender and counted with "en" instead of "ett", like "tjugoen miljoner".
+			count := svHundreds(g[i])
+			if strings.HasSuffix(count, "ett") {
+				count = strings.TrimSuffix(count, "ett") + "en"
+			}
+			words = append(words, count, svScales[i][1])
+		}
+	}
+	if below := g[1]*1000 + g[0]; below > 0 {
+		var word string
+		if g[1] > 0 {
+			word = strings.Replace(svHundreds(g[1])+"tusen", "tttusen", "ttusen", 1)
+		}
+		words = append(words, word+svHundreds(g[0]))
+	}
+	return strings.Join(words, " ")
+}
+
+func svHundreds(n int) string {
+	var s string
+	if n >= 100 {
+		s = svOnes[n/100] + "hundra"
+		n %= 100
+	}
+	switch {
+	case n >= 20 && n%10 > 0:
+		s += svTens[n/10] + svOnes[n%10]
+	case n >= 20:
+		s += svTens[n/10]
+	case n > 0:
+		s += svOnes[n]
+	}
+	return s
+}
@@ -0,0 +1,81 @@
+package numeral
+
+import (
+	"testing"
+)
+
+func TestEnglish(t *testing.T) {
+	testCardinal(t, "en", map[uint64]string{
+		0:          "zero",
+		21:         "twenty-one",
+		123:        "one hundred twenty-three",
+		1005:       "one thousand five",
+		1500000000: "one billion five hundred million",
+	})
+	testCardinal(t, "en-GB", map[uint64]string{
+		123:     "one hundred and twenty-three",
+		1005:    "one thousand and five",
+		1200:    "one thousand two hundred",
+		2000101: "two million one hundred and one",
+	})
+}
+
+func TestGerman(t *testing.T) {
+	testCardinal(t, "de", map[uint64]string{
+		0:          "null",
+		1:          "eins",
+		21:         "einundzwanzig",
+		101:        "einhunderteins",
+		123:        "einhundertdreiundzwanzig",
+		1000:       "eintausend",
+		1234:       "eintausendzweihundertvierunddreißig",
+		21000:      "einundzwanzigtausend",
+		1000000:    "eine Million",
+		2500000:    "zwei Millionen fünfhunderttausend",
+		1000000001: "eine Milliarde eins",
+	})
+	testCardinal(t, "de-CH", map[uint64]string{
+		30: "dreissig",
+	})
+}
+
+func TestDutch(t *testing.T) {
+	testCardinal(t, "nl", map[uint64]string{
+		1:       "één",
+		21:      "eenentwintig",
+		23:      "drieëntwintig",
+		123:     "honderddrieëntwintig",
+		1234:    "duizend tweehonderdvierendertig",
+		2000000: "twee miljoen",
+	})
+}
+
+func TestDanish(t *testing.T) {
+	testCardinal(t, "da", map[uint64]string{
+		21:   "enogtyve",
+		50:   "halvtreds",
+		75:   "femoghalvfjerds",
+		99:   "nioghalvfems",
+		123:  "et hundrede og treogtyve",
+		1005: "et tusind og fem",
+	})
+}
+
+func TestNorwegian(t *testing.T) {
+	testCardinal(t, "no", map[uint64]string{
+		21:      "tjueen",
+		123:     "ett hundre og tjuetre",
+		1234:    "ett tusen to hundre og trettifire",
+		2000000: "to millioner",
+	})
+}
+
+func TestSwedish(t *testing.T) {
+	testCardinal(t, "sv", map[uint64]string{
+		1:        "ett",
+		123:      "etthundratjugotre",
+		1000:     "ettusen",
+		21000:    "tjugoettusen",
+		21000000: "tjugoen miljoner",
+	})
+}
@@ -0,0 +1,127 @@
+package numeral
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ICAO reads numbers in radiotelephony as prescribed by ICAO Annex 10 Volume II and Doc 9432, like "Tree Tousand".
+//
+// Digits are said one by one, like "One Two Fife" for 125, with Tree, Fife and Niner for 3, 5 and 9. Whole hundreds
+// and thousands are said with Hundred and Tousand, like "One Zero Tousand Fife Hundred" for altitudes like 10500.
+// Frequencies, flight levels, headings, squawk codes, QNH and runways are always said digit by digit, like
+// "One One Eight Decimal One" for 118.100 or "Flight Level Zero Eight Zero" for FL80.
+var ICAO Reader = icao{}
+
+var icaoDigits = []string{"Zero", "One", "Two", "Tree", "Four", "Fife", "Six", "Seven", "Eight", "Niner"}
+
+// icaoKeyword is a keyword before a number, which is said digit by digit.
+type icaoKeyword struct {
+	// Abbreviations of the keyword in texts, like "FL" or "flight level".
+	abbreviations []string
+	// word says the keyword.
+	word string
+	// width pads the number with leading zeros, like the three digits of a heading.
+	width int
+}
+
+var icaoKeywords = []icaoKeyword{
+	{[]string{"flight level", "FL"}, "Flight Level", 3},
+	{[]string{"heading", "HDG"}, "Heading", 3},
+	{[]string{"squawk"}, "Squawk", 4},
+	{[]string{"QNH"}, "QNH", 0},
+	{[]string{"runway", "RWY"}, "Runway", 2},
+}
+
+type icao struct{}
+
+// Find finds the numbers in text, together with a keyword before them, like "FL 180". Thousands may be separated by
+// commas.
+func (icao) Find(text string) []Number {
+	var numbers []Number
+	for i := 0; i < len(text); {
+		if !isDigit(text[i]) {
+			i++
+			continue
+		}
+		n := All[0].number(text, i)
+		if k, start, ok := icaoKeywordBefore(text[:n.Start]); ok {
+			n.Start, n.Keyword = start, k.word
+		}
+		numbers = append(numbers, n)
+		i = n.End
+	}
+	return numbers
+}
+
+// icaoKeywordBefore finds a keyword at the end of text, optionally followed by a space. It returns the keyword and
+// its start in text.
+func icaoKeywordBefore(text string) (icaoKeyword, int, bool) {
+	trimmed := strings.TrimSuffix(text, " ")
+	for _, k := range icaoKeywords {
+		for _, a := range k.abbreviations {
+			start := len(trimmed) - len(a)
+			if start < 0 || !strings.EqualFold(trimmed[start:], a) {
+				continue
+			}
+			if r, _ := utf8.DecodeLastRuneInString(trimmed[:start]); start == 0 || !unicode.IsLetter(r) {
+				return k, start, true
+			}
+		}
+	}
+	return icaoKeyword{}, 0, false
+}
+
+// Read reads a Number found by Find in words, like "Heading Zero Niner Zero" for "heading 090".
+func (icao) Read(n Number) string {
+	var words []string
+	switch {
+	case n.Keyword != "":
+		words = append(words, n.Keyword)
+		for _, k := range icaoKeywords {
+			if k.word == n.Keyword && len(n.Integer) < k.width {
+				n.Integer = strings.Repeat("0", k.width-len(n.Integer)) + n.Integer
+			}
+		}
+		words = append(words, icaoDigitWords(n.Integer)...)
+	case n.Fraction != "":
+		words = append(words, icaoDigitWords(n.Integer)...)
+	default:
+		words = append(words, icaoWholeWords(n.Integer)...)
+	}
+	if n.Fraction != "" {
+		// Frequencies with six digits are said with four, if the last two are zero, like 118.100.
+		fraction := n.Fraction
+		if len(fraction) == 3 && strings.HasSuffix(fraction, "00") {
+			fraction = fraction[:1]
+		}
+		words = append(words, "Decimal")
+		words = append(words, icaoDigitWords(fraction)...)
+	}
+	return strings.Join(words, " ")
+}
+
+// icaoWholeWords says whole hundreds and thousands with Hundred and Tousand and all other numbers digit by digit.
+func icaoWholeWords(digits string) []string {
+	if len(digits) < 3 || digits[0] == '0' || !strings.HasSuffix(digits, "00") {
+		return icaoDigitWords(digits)
+	}
+	var words []string
+	if thousands := digits[:len(digits)-3]; thousands != "" {
+		words = append(icaoDigitWords(thousands), "Tousand")
+	}
+	if hundreds := digits[len(digits)-3 : len(digits)-2]; hundreds != "0" {
+		words = append(words, icaoDigitWords(hundreds)...)
+		words = append(words, "Hundred")
+	}
+	return words
+}
+
+func icaoDigitWords(digits string) []string {
+	words := make([]string, 0, len(digits))
+	for _, d := range digits {
+		words = append(words, icaoDigits[d-'0'])
+	}
+	return words
+}
@@ -0,0 +1,45 @@
+package numeral
+
+import (
+	"testing"
+)
+
+func TestICAO(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"10", []string{"One Zero"}},
+		{"75", []string{"Seven Fife"}},
+		{"100", []string{"One Hundred"}},
+		{"2500", []string{"Two Tousand Fife Hundred"}},
+		{"3000", []string{"Tree Tousand"}},
+		{"11000", []string{"One One Tousand"}},
+		{"12,500 ft", []string{"One Two Tousand Fife Hundred"}},
+		{"38143", []string{"Tree Eight One Four Tree"}},
+		{"0900", []string{"Zero Niner Zero Zero"}},
+		{"118.1", []string{"One One Eight Decimal One"}},
+		{"118.100", []string{"One One Eight Decimal One"}},
+		{"118.025", []string{"One One Eight Decimal Zero Two Fife"}},
+		{"climb FL180", []string{"Flight Level One Eight Zero"}},
+		{"flight level 80", []string{"Flight Level Zero Eight Zero"}},
+		{"Heading 90", []string{"Heading Zero Niner Zero"}},
+		{"squawk 7500", []string{"Squawk Seven Fife Zero Zero"}},
+		{"QNH 1000", []string{"QNH One Zero Zero Zero"}},
+		{"RWY 9, wind 200", []string{"Runway Zero Niner", "Two Hundred"}},
+		{"SHDG 200", []string{"Two Hundred"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			numbers := ICAO.Find(tt.text)
+			if len(numbers) != len(tt.want) {
+				t.Fatalf("Find() = %v, want %v", numbers, tt.want)
+			}
+			for i, n := range numbers {
+				if got := ICAO.Read(n); got != tt.want[i] {
+					t.Errorf("Read() = %v, want %v", got, tt.want[i])
+				}
+			}
+		})
+	}
+}
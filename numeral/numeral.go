@@ -0,0 +1,221 @@
+// Package numeral reads numbers as words in the languages of the spelling alphabets, like "einhundertdreiundzwanzig"
+// for 123 in German. Clients should not use this internal package, used by github.com/simonnagl/spell/cmd/spell.
+package numeral
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"strconv"
+	"strings"
+)
+
+// limit is the first number, which is too large to be read as words.
+const limit = 1000000000000000
+
+// Reader finds numbers in texts and reads them as words.
+type Reader interface {
+	// Find finds the numbers in text.
+	Find(text string) []Number
+	// Read reads a Number found by Find as words.
+	Read(n Number) string
+}
+
+// Language reads numbers in the words of a language.
+type Language struct {
+	// BCP 47 language tag of the Language.
+	lang language.Tag
+	// decimal separates the integer part of a number from its fraction, like "," in "3,5".
+	decimal string
+	// groups separate the thousands of a number, like "." in "1.234".
+	groups []string
+	// point is the word for the decimal separator, like "Komma".
+	point string
+	// cardinal reads a number below limit as words.
+	cardinal func(n uint64) string
+}
+
+// LangTag returns the BCP 47 tag of the Language.
+func (l Language) LangTag() string {
+	return l.lang.String()
+}
+
+// Cardinal reads n as cardinal number, like "einhundertdreiundzwanzig" for 123 in German.
+// Numbers of a quadrillion and above are too large.
+func (l Language) Cardinal(n uint64) (string, error) {
+	if n >= limit {
+		return "", fmt.Errorf("%d is too large", n)
+	}
+	return l.cardinal(n), nil
+}
+
+// Number is a number found in a text.
+type Number struct {
+	// Start and End are the byte offsets of the Number in the text.
+	Start, End int
+	// Integer are the digits of the integer part without thousands separators, like "1234" of "1.234,5".
+	Integer string
+	// Fraction are the digits after the decimal separator, like "5" of "1.234,5".
+	Fraction string
+	// Keyword is the word of a keyword before the Number, which is read together with it, like "Flight Level" of
+	// "FL180". Only radiotelephony finds keywords.
+	Keyword string
+}
+
+// Find finds the numbers in text, which are written with the decimal and thousands separators of l.
+//
+// Thousands are only separated by non-breaking spaces and not by spaces, which separate numbers from each other.
+// Runs of digits with a leading zero, like "0815", are codes and no numbers. Numbers, which are too large to be read
+// as words, are not found either.
+func (l Language) Find(text string) []Number {
+	var numbers []Number
+	for i := 0; i < len(text); {
+		if !isDigit(text[i]) {
+			i++
+			continue
+		}
+		n := l.number(text, i)
+		if readable(n) {
+			numbers = append(numbers, n)
+		}
+		i = n.End
+	}
+	return numbers
+}
+
+// number reads the number in text starting with the digit at start.
+func (l Language) number(text string, start int) Number {
+	end := digitsEnd(text, start)
+	n := Number{Start: start, Integer: text[start:end]}
+	if len(n.Integer) <= 3 {
+		for {
+			sep := l.groupAt(text[end:])
+			if sep == "" {
+				break
+			}
+			group := end + len(sep)
+			if digitsEnd(text, group)-group != 3 {
+				break
+			}
+			n.Integer += text[group : group+3]
+			end = group + 3
+		}
+	}
+	if strings.HasPrefix(text[end:], l.decimal) {
+		fraction := end + len(l.decimal)
+		if e := digitsEnd(text, fraction); e > fraction {
+			n.Fraction = text[fraction:e]
+			end = e
+		}
+	}
+	n.End = end
+	return n
+}
+
+// groupAt returns the thousands separator at the start of s or "".
+func (l Language) groupAt(s string) string {
+	for _, sep := range l.groups {
+		if strings.HasPrefix(s, sep) {
+			return sep
+		}
+	}
+	return ""
+}
+
+// Read reads a Number found by Find as words, like "drei Komma eins vier" for "3,14" in German.
+// The fraction is read digit by digit.
+func (l Language) Read(n Number) string {
+	i, _ := strconv.ParseUint(n.Integer, 10, 64)
+	words := []string{l.cardinal(i)}
+	if n.Fraction != "" {
+		words = append(words, l.point)
+		for _, d := range n.Fraction {
+			words = append(words, l.cardinal(uint64(d-'0')))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func readable(n Number) bool {
+	if len(n.Integer) > 1 && n.Integer[0] == '0' {
+		return false
+	}
+	i, err := strconv.ParseUint(n.Integer, 10, 64)
+	return err == nil && i < limit
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// digitsEnd returns the index after the run of digits in s starting at i.
+func digitsEnd(s string, i int) int {
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+// groups splits n into groups of three digits, starting with the ones, followed by the thousands, the millions, the
+// billions and the trillions.
+func groups(n uint64) [5]int {
+	var g [5]int
+	for i := range g {
+		g[i] = int(n % 1000)
+		n /= 1000
+	}
+	return g
+}
+
+// Lookup returns the best matching Language of All.
+//
+// Lookup interprets lang as a BCP 47 language tag. If there is no match, English is used.
+func Lookup(lang string) Language {
+	tags := make([]language.Tag, 0, len(All))
+	for _, l := range All {
+		tags = append(tags, l.lang)
+	}
+
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return All[0]
+	}
+	_, i, c := language.NewMatcher(tags).Match(tag)
+	if c == language.No {
+		return All[0]
+	}
+	return All[i]
+}
+
+var (
+	// pointGroups are the thousands separators of languages, which write numbers like 1,234.5.
+	pointGroups = []string{","}
+	// commaGroups are the thousands separators of languages, which write numbers like 1.234,5.
+	commaGroups = []string{".", "\u00a0", "\u202f"}
+	// spaceGroups are the thousands separators of languages, which write numbers like 1 234,5.
+	spaceGroups = []string{"\u00a0", "\u202f"}
+)
+
+// All Language.
+var All = []Language{
+	{language.English, ".", pointGroups, "point", english(false)},
+	{language.BritishEnglish, ".", pointGroups, "point", english(true)},
+	{language.French, ",", spaceGroups, "virgule", french},
+	{language.Dutch, ",", commaGroups, "komma", dutch},
+	{language.German, ",", commaGroups, "Komma", german(false)},
+	{language.MustParse("de-CH"), ".", []string{"'", "\u2019"}, "Komma", german(true)},
+	{language.Italian, ",", commaGroups, "virgola", italian},
+	{language.Spanish, ",", commaGroups, "coma", spanish},
+	{language.Turkish, ",", commaGroups, "virgül", turkish},
+	{language.Norwegian, ",", spaceGroups, "komma", norwegian.cardinal},
+	{language.Swedish, ",", spaceGroups, "komma", swedish},
+	{language.Finnish, ",", spaceGroups, "pilkku", finnish},
+	{language.Danish, ",", commaGroups, "komma", danish.cardinal},
+	{language.Czech, ",", spaceGroups, "čárka", czech.cardinal},
+	{language.Polish, ",", spaceGroups, "przecinek", polish.cardinal},
+	{language.EuropeanPortuguese, ",", spaceGroups, "vírgula", portuguese(false)},
+	{language.BrazilianPortuguese, ",", commaGroups, "vírgula", portuguese(true)},
+	{language.Romanian, ",", commaGroups, "virgulă", romanian},
+	{language.Slovenian, ",", commaGroups, "vejica", slovenian},
+	{language.Russian, ",", spaceGroups, "запятая", russian.cardinal},
+	{language.Ukrainian, ",", spaceGroups, "кома", ukrainian.cardinal},
+}
@@ -0,0 +1,109 @@
+package numeral
+
+import (
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"de", "de"},
+		{"de-AT", "de"},
+		{"de-CH", "de-CH"},
+		{"pt", "pt-BR"},
+		{"pt-PT", "pt-PT"},
+		{"pl-PL", "pl"},
+		{"xx", "en"},
+		{"not a tag", "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			if got := Lookup(tt.lang).LangTag(); got != tt.want {
+				t.Errorf("Lookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguage_Cardinal(t *testing.T) {
+	if _, err := Lookup("en").Cardinal(limit); err == nil {
+		t.Errorf("Cardinal(%d) should fail", uint64(limit))
+	}
+	if got, err := Lookup("en").Cardinal(limit - 1); err != nil || got == "" {
+		t.Errorf("Cardinal(%d) = %v, %v", uint64(limit-1), got, err)
+	}
+}
+
+func TestLanguage_Find(t *testing.T) {
+	tests := []struct {
+		lang string
+		text string
+		want []string
+	}{
+		{"en", "Invoice 4711", []string{"4711"}},
+		{"en", "1,234.5 and 1,23", []string{"1,234.5", "1", "23"}},
+		{"en", "3. Place", []string{"3"}},
+		{"de", "1.234,5 €", []string{"1.234,5"}},
+		{"de", "19.10.2026", []string{"19", "10", "2026"}},
+		{"de", "1\u00a0234", []string{"1\u00a0234"}},
+		{"de", "1 234", []string{"1", "234"}},
+		{"de-CH", "1'234.50", []string{"1'234.50"}},
+		{"fr", "1\u202f234,5", []string{"1\u202f234,5"}},
+		{"pl", "1\u00a0234,5 zł", []string{"1\u00a0234,5"}},
+		{"en", "0815 0 0.5", []string{"0", "0.5"}},
+		{"en", "1234567890123456", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang+" "+tt.text, func(t *testing.T) {
+			got := Lookup(tt.lang).Find(tt.text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Find() = %v, want %v", got, tt.want)
+			}
+			for i, n := range got {
+				if text := tt.text[n.Start:n.End]; text != tt.want[i] {
+					t.Errorf("Find()[%d] = %v, want %v", i, text, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLanguage_Read(t *testing.T) {
+	tests := []struct {
+		lang string
+		text string
+		want string
+	}{
+		{"en", "1,234.56", "one thousand two hundred thirty-four point five six"},
+		{"de", "1.234,5", "eintausendzweihundertvierunddreißig Komma fünf"},
+		{"de-CH", "1'230.05", "eintausendzweihundertdreissig Komma null fünf"},
+		{"fr", "3,14", "trois virgule un quatre"},
+		{"ru", "2,5", "два запятая пять"},
+		{"pl", "21\u00a0000,5", "dwadzieścia jeden tysięcy przecinek pięć"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang+" "+tt.text, func(t *testing.T) {
+			l := Lookup(tt.lang)
+			numbers := l.Find(tt.text)
+			if len(numbers) != 1 {
+				t.Fatalf("Find() = %v, want one number", numbers)
+			}
+			if got := l.Read(numbers[0]); got != tt.want {
+				t.Errorf("Read() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testCardinal tests the cardinal numbers of the Language of lang.
+func testCardinal(t *testing.T, lang string, tests map[uint64]string) {
+	t.Helper()
+	l := Lookup(lang)
+	for n, want := range tests {
+		if got, err := l.Cardinal(n); err != nil || got != want {
+			t.Errorf("%s Cardinal(%d) = %v, %v, want %v", lang, n, got, err, want)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+package numeral
+
+import (
+	"strings"
+)
+
+var (
+	frOnes = []string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix", "onze", "douze",
+		"treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"}
+	frTens = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante", "soixante", "quatre-vingt",
+		"quatre-vingt"}
+	// frScales are the singular and plural of the scales from a million on.
+	frScales = [][2]string{{}, {}, {"million", "millions"}, {"milliard", "milliards"}, {"billion", "billions"}}
+)
+
+// french reads numbers in French, which counts in twenties from 60 to 99, like "quatre-vingt-dix-sept" for 97.
+func french(n uint64) string {
+	if n == 0 {
+		return frOnes[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 2; i-- {
+		switch g[i] {
+		case 0:
+		case 1:
+			words = append(words, "un", frScales[i][0])
+		default:
+			words = append(words, frHundreds(g[i], true), frScales[i][1])
+		}
+	}
+	switch g[1] {
+	case 0:
+	case 1:
+		words = append(words, "mille")
+	default:
+		// Mille is invariable and no noun, so cent and vingt before it stay singular.
+		words = append(words, frHundreds(g[1], false), "mille")
+	}
+	if g[0] > 0 {
+		words = append(words, frHundreds(g[0], true))
+	}
+	return strings.Join(words, " ")
+}
+
+// frHundreds reads n below a thousand. Round multiples of cent and quatre-vingt take the plural, if they are final.
+func frHundreds(n int, final bool) string {
+	var words []string
+	switch h := n / 100; {
+	case h == 1:
+		words = append(words, "cent")
+	case h > 1 && n%100 == 0 && final:
+		words = append(words, frOnes[h], "cents")
+	case h > 1:
+		words = append(words, frOnes[h], "cent")
+	}
+	if n %= 100; n > 0 {
+		words = append(words, frTens100(n, final))
+	}
+	return strings.Join(words, " ")
+}
+
+func frTens100(n int, final bool) string {
+	t, u := n/10, n%10
+	switch {
+	case n < 20:
+		return frOnes[n]
+	case t == 7 && u == 1:
+		return frTens[t] + " et onze"
+	case t == 7 || t == 9:
+		return frTens[t] + "-" + frOnes[10+u]
+	case t == 8 && u == 0 && final:
+		return frTens[t] + "s"
+	case t == 8 && u == 0:
+		return frTens[t]
+	case t == 8:
+		return frTens[t] + "-" + frOnes[u]
+	case u == 0:
+		return frTens[t]
+	case u == 1:
+		return frTens[t] + " et un"
+	}
+	return frTens[t] + "-" + frOnes[u]
+}
+
+var (
+	itOnes = []string{"zero", "uno", "due", "tre", "quattro", "cinque", "sei", "sette", "otto", "nove", "dieci", "undici",
+		"dodici", "tredici", "quattordici", "quindici", "sedici", "diciassette", "diciotto", "diciannove"}
+	itTens = []string{"", "", "venti", "trenta", "quaranta", "cinquanta", "sessanta", "settanta", "ottanta", "novanta"}
+	// itScales are the singular and plural of the scales from a million on.
+	itScales = [][2]string{{}, {}, {"milione", "milioni"}, {"miliardo", "miliardi"}, {"bilione", "bilioni"}}
+)
+
+// italian reads numbers in Italian. Numbers below a million are written in one word, like "milleduecentotrentaquattro".
+func italian(n uint64) string {
+	if n == 0 {
+		return itOnes[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 2; i-- {
+		switch g[i] {
+		case 0:
+		case 1:
+			words = append(words, "un", itScales[i][0])
+		default:
+			words = append(words, itStress(itHundreds(g[i])), itScales[i][1])
+		}
+	}
+	if below := g[1]*1000 + g[0]; below > 0 {
+		var word string
+		switch g[1] {
+		case 0:
+		case 1:
+			word = "mille"
+		default:
+			word = itHundreds(g[1]) + "mila"
+		}
+		words = append(words, itStress(word+itHundreds(g[0])))
+	}
+	return strings.Join(words, " ")
+}
+
+func itHundreds(n int) string {
+	var s string
+	switch h := n / 100; {
+	case h == 1:
+		s = "cento"
+	case h > 1:
+		s = itOnes[h] + "cento"
+	}
+	n %= 100
+	var tens string
+	switch {
+	case n >= 20 && n%10 > 0:
+		tens = itElide(itTens[n/10], itOnes[n%10])
+	case n >= 20:
+		tens = itTens[n/10]
+	case n > 0:
+		tens = itOnes[n]
+	}
+	if strings.HasPrefix(tens, "o") {
+		return strings.TrimSuffix(s, "o") + tens
+	}
+	return s + tens
+}
+
+// itStress stresses the final tre of a compound, like "ventitré".
+func itStress(word string) string {
+	if word != "tre" && strings.HasSuffix(word, "tre") {
+		return strings.TrimSuffix(word, "tre") + "tré"
+	}
+	return word
+}
+
+// itElide joins tens and a unit. The tens lose their final vowel before a vowel, like "ventuno" and "trentotto".
+func itElide(tens, unit string) string {
+	if strings.ContainsAny(unit[:1], "aeiou") {
+		return tens[:len(tens)-1] + unit
+	}
+	return tens + unit
+}
+
+var (
+	esOnes = []string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez", "once",
+		"doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve", "veinte", "veintiuno",
+		"veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho",
+		"veintinueve"}
+	esTens     = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	esHundreds = []string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos",
+		"setecientos", "ochocientos", "novecientos"}
+)
+
+// spanish reads numbers in Spanish, which counts in the long scale, like "mil millones" for a billion.
+func spanish(n uint64) string {
+	if n == 0 {
+		return esOnes[0]
+	}
+	var words []string
+	for _, scale := range []struct {
+		n                uint64
+		singular, plural string
+	}{{1000000000000, "un billón", "billones"}, {1000000, "un millón", "millones"}} {
+		switch count := n / scale.n % 1000000; count {
+		case 0:
+		case 1:
+			words = append(words, scale.singular)
+		default:
+			words = append(words, esApocope(esThousands(int(count))), scale.plural)
+		}
+	}
+	if below := int(n % 1000000); below > 0 {
+		words = append(words, esThousands(below))
+	}
+	return strings.Join(words, " ")
+}
+
+// esThousands reads n below a million.
+func esThousands(n int) string {
+	var words []string
+	switch n / 1000 {
+	case 0:
+	case 1:
+		words = append(words, "mil")
+	default:
+		words = append(words, esApocope(esHundreds100(n/1000)), "mil")
+	}
+	if n %= 1000; n > 0 {
+		words = append(words, esHundreds100(n))
+	}
+	return strings.Join(words, " ")
+}
+
+func esHundreds100(n int) string {
+	if n == 100 {
+		return "cien"
+	}
+	var words []string
+	if n >= 100 {
+		words = append(words, esHundreds[n/100])
+	}
+	switch n %= 100; {
+	case n >= 30 && n%10 > 0:
+		words = append(words, esTens[n/10], "y", esOnes[n%10])
+	case n >= 30:
+		words = append(words, esTens[n/10])
+	case n > 0:
+		words = append(words, esOnes[n])
+	}
+	return strings.Join(words, " ")
+}
+
+// esApocope shortens a final uno before a noun, like "veintiún mil" and "treinta y un millones".
+func esApocope(s string) string {
+	switch {
+	case strings.HasSuffix(s, "veintiuno"):
+		return strings.TrimSuffix(s, "uno") + "ún"
+	case strings.HasSuffix(s, "uno"):
+		return strings.TrimSuffix(s, "o")
+	}
+	return s
+}
+
+var (
+	ptTens     = []string{"", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"}
+	ptHundreds = []string{"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos", "seiscentos", "setecentos",
+		"oitocentos", "novecentos"}
+)
+
+// portuguese reads numbers in Portuguese. Brazil counts in the short scale, like "um bilhão", and Portugal in the long
+// scale, like "mil milhões".
+func portuguese(brazil bool) func(n uint64) string {
+	ones := []string{"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove", "dez", "onze", "doze",
+		"treze", "catorze", "quinze", "dezasseis", "dezassete", "dezoito", "dezanove"}
+	scales := [][2]string{{}, {}, {"milhão", "milhões"}, {}, {"bilião", "biliões"}}
+	if brazil {
+		ones[16], ones[17], ones[19] = "dezesseis", "dezessete", "dezenove"
+		scales = [][2]string{{}, {}, {"milhão", "milhões"}, {"bilhão", "bilhões"}, {"trilhão", "trilhões"}}
+	}
+
+	hundreds := func(n int) string {
+		if n == 100 {
+			return "cem"
+		}
+		var words []string
+		if n >= 100 {
+			words = append(words, ptHundreds[n/100])
+		}
+		switch n %= 100; {
+		case n >= 20 && n%10 > 0:
+			words = append(words, ptTens[n/10]+" e "+ones[n%10])
+		case n >= 20:
+			words = append(words, ptTens[n/10])
+		case n > 0:
+			words = append(words, ones[n])
+		}
+		return strings.Join(words, " e ")
+	}
+
+	return func(n uint64) string {
+		if n == 0 {
+			return ones[0]
+		}
+		// parts are the words of each group of three digits together with the value of the group.
+		var parts []string
+		var values []int
+		add := func(part string, value int) {
+			parts = append(parts, part)
+			values = append(values, value)
+		}
+
+		g := groups(n)
+		for i := len(g) - 1; i >= 2; i-- {
+			scale := scales[i]
+			if !brazil && i == 3 {
+				// The long scale counts a thousand millions, like "mil milhões".
+				switch {
+				case g[3] == 0:
+				case g[2] == 0 && g[3] == 1:
+					add("mil milhões", g[3])
+				case g[2] == 0:
+					add(hundreds(g[3])+" mil milhões", g[3])
+				case g[3] == 1:
+					add("mil", g[3])
+				default:
+					add(hundreds(g[3])+" mil", g[3])
+				}
+				continue
+			}
+			switch {
+			case g[i] == 0:
+			case g[i] == 1 && (brazil || g[3] == 0 || i != 2):
+				add("um "+scale[0], g[i])
+			default:
+				add(hundreds(g[i])+" "+scale[1], g[i])
+			}
+		}
+		switch g[1] {
+		case 0:
+		case 1:
+			add("mil", g[1])
+		default:
+			add(hundreds(g[1])+" mil", g[1])
+		}
+		if g[0] > 0 {
+			add(hundreds(g[0]), g[0])
+		}
+
+		// The last part is joined with "e", if it is below a hundred or a round hundred, like "mil e duzentos".
+		if last := values[len(values)-1]; len(parts) > 1 && (last < 100 || last%100 == 0) {
+			return strings.Join(parts[:len(parts)-1], " ") + " e " + parts[len(parts)-1]
+		}
+		return strings.Join(parts, " ")
+	}
+}
+
+var (
+	roOnes = []string{"zero", "unu", "doi", "trei", "patru", "cinci", "șase", "șapte", "opt", "nouă", "zece", "unsprezece",
+		"doisprezece", "treisprezece", "paisprezece", "cincisprezece", "șaisprezece", "șaptesprezece", "optsprezece",
+		"nouăsprezece"}
+	roTens = []string{"", "", "douăzeci", "treizeci", "patruzeci", "cincizeci", "șaizeci", "șaptezeci", "optzeci",
+		"nouăzeci"}
+)
+
+// roScale is a Romanian scale, which is counted with feminine or neuter forms, like "două mii" and "două milioane".
+type roScale struct {
+	one, plural string
+	feminine    bool
+}
+
+var roScales = []roScale{{}, {"o mie", "mii", true}, {"un milion", "milioane", false}, {"un miliard", "miliarde", false},
+	{"un bilion", "bilioane", false}}
+
+// romanian reads numbers in Romanian. Counts of scales agree with their gender and take "de" from twenty on, like
+// "douăzeci și una de mii".
+func romanian(n uint64) string {
+	if n == 0 {
+		return roOnes[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 1; i-- {
+		switch scale := roScales[i]; g[i] {
+		case 0:
+		case 1:
+			words = append(words, scale.one)
+		default:
+			words = append(words, roHundreds(g[i], true, scale.feminine))
+			if g[i]%100 == 0 || g[i]%100 >= 20 {
+				words = append(words, "de")
+			}
+			words = append(words, scale.plural)
+		}
+	}
+	if g[0] > 0 {
+		words = append(words, roHundreds(g[0], false, false))
+	}
+	return strings.Join(words, " ")
+}
+
+// roHundreds reads n below a thousand. Counts of feminine and neuter scales say "două" instead of "doi" and
+// feminine ones "una" instead of "unu".
+func roHundreds(n int, count, feminine bool) string {
+	var words []string
+	switch h := n / 100; h {
+	case 0:
+	case 1:
+		words = append(words, "o sută")
+	case 2:
+		words = append(words, "două sute")
+	default:
+		words = append(words, roOnes[h], "sute")
+	}
+	unit := func(u int) string {
+		switch {
+		case u == 1 && count && feminine:
+			return "una"
+		case u == 2 && count:
+			return "două"
+		}
+		return roOnes[u]
+	}
+	switch n %= 100; {
+	case n == 12 && count:
+		words = append(words, "douăsprezece")
+	case n >= 20 && n%10 > 0:
+		words = append(words, roTens[n/10], "și", unit(n%10))
+	case n >= 20:
+		words = append(words, roTens[n/10])
+	case n > 0:
+		words = append(words, unit(n))
+	}
+	return strings.Join(words, " ")
+}
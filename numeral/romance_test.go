@@ -0,0 +1,78 @@
+package numeral
+
+import (
+	"testing"
+)
+
+func TestFrench(t *testing.T) {
+	testCardinal(t, "fr", map[uint64]string{
+		21:      "vingt et un",
+		71:      "soixante et onze",
+		77:      "soixante-dix-sept",
+		80:      "quatre-vingts",
+		81:      "quatre-vingt-un",
+		91:      "quatre-vingt-onze",
+		99:      "quatre-vingt-dix-neuf",
+		200:     "deux cents",
+		201:     "deux cent un",
+		80000:   "quatre-vingt mille",
+		2000:    "deux mille",
+		200000:  "deux cent mille",
+		2000000: "deux millions",
+	})
+}
+
+func TestItalian(t *testing.T) {
+	testCardinal(t, "it", map[uint64]string{
+		3:        "tre",
+		21:       "ventuno",
+		23:       "ventitré",
+		38:       "trentotto",
+		180:      "centottanta",
+		1000:     "mille",
+		2003:     "duemilatré",
+		1234:     "milleduecentotrentaquattro",
+		23000000: "ventitré milioni",
+	})
+}
+
+func TestSpanish(t *testing.T) {
+	testCardinal(t, "es", map[uint64]string{
+		21:         "veintiuno",
+		31:         "treinta y uno",
+		100:        "cien",
+		101:        "ciento uno",
+		21000:      "veintiún mil",
+		31000000:   "treinta y un millones",
+		1500000000: "mil quinientos millones",
+	})
+}
+
+func TestPortuguese(t *testing.T) {
+	testCardinal(t, "pt-BR", map[uint64]string{
+		16:         "dezesseis",
+		123:        "cento e vinte e três",
+		1200:       "mil e duzentos",
+		1234:       "mil duzentos e trinta e quatro",
+		1500000000: "um bilhão e quinhentos milhões",
+	})
+	testCardinal(t, "pt-PT", map[uint64]string{
+		16:         "dezasseis",
+		1000000000: "mil milhões",
+		1500000000: "mil e quinhentos milhões",
+	})
+}
+
+func TestRomanian(t *testing.T) {
+	testCardinal(t, "ro", map[uint64]string{
+		2:       "doi",
+		21:      "douăzeci și unu",
+		1000:    "o mie",
+		2000:    "două mii",
+		12000:   "douăsprezece mii",
+		19000:   "nouăsprezece mii",
+		21000:   "douăzeci și una de mii",
+		100000:  "o sută de mii",
+		2000000: "două milioane",
+	})
+}
@@ -0,0 +1,267 @@
+package numeral
+
+import (
+	"strings"
+)
+
+// Forms of nouns counted by a number, like "тысяча", "тысячи" and "тысяч".
+const (
+	singular = iota
+	paucal
+	plural
+)
+
+// slavic are the number words of Czech, Polish, Russian and Ukrainian, which count scales in the singular, in the
+// plural for two to four and in the genitive plural from five on, like "две тысячи" and "пять тысяч".
+type slavic struct {
+	ones, tens, hundreds []string
+	// feminine one and two, which count feminine scales, like "две тысячи".
+	feminine [3]string
+	// masculine one and two, which count masculine scales, like "dva miliony".
+	masculine [3]string
+	// scales are the forms of the scales from a thousand on.
+	scales []slavicScale
+	// omitOne omits the count of a single scale, like "tisíc" instead of "jeden tisíc".
+	omitOne bool
+	// singularOne counts scales in the singular only for one and not for 21, 31 and so on, like "dwadzieścia jeden
+	// tysięcy".
+	singularOne bool
+}
+
+type slavicScale struct {
+	forms    [3]string
+	feminine bool
+}
+
+var russian = slavic{
+	ones: []string{"ноль", "один", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять", "десять",
+		"одиннадцать", "двенадцать", "тринадцать", "четырнадцать", "пятнадцать", "шестнадцать", "семнадцать",
+		"восемнадцать", "девятнадцать"},
+	tens: []string{"", "", "двадцать", "тридцать", "сорок", "пятьдесят", "шестьдесят", "семьдесят", "восемьдесят",
+		"девяносто"},
+	hundreds: []string{"", "сто", "двести", "триста", "четыреста", "пятьсот", "шестьсот", "семьсот", "восемьсот",
+		"девятьсот"},
+	feminine:  [3]string{"", "одна", "две"},
+	masculine: [3]string{"", "один", "два"},
+	scales: []slavicScale{
+		{[3]string{"тысяча", "тысячи", "тысяч"}, true},
+		{[3]string{"миллион", "миллиона", "миллионов"}, false},
+		{[3]string{"миллиард", "миллиарда", "миллиардов"}, false},
+		{[3]string{"триллион", "триллиона", "триллионов"}, false},
+	},
+}
+
+var ukrainian = slavic{
+	ones: []string{"нуль", "один", "два", "три", "чотири", "п'ять", "шість", "сім", "вісім", "дев'ять", "десять",
+		"одинадцять", "дванадцять", "тринадцять", "чотирнадцять", "п'ятнадцять", "шістнадцять", "сімнадцять",
+		"вісімнадцять", "дев'ятнадцять"},
+	tens: []string{"", "", "двадцять", "тридцять", "сорок", "п'ятдесят", "шістдесят", "сімдесят", "вісімдесят",
+		"дев'яносто"},
+	hundreds: []string{"", "сто", "двісті", "триста", "чотириста", "п'ятсот", "шістсот", "сімсот", "вісімсот",
+		"дев'ятсот"},
+	feminine:  [3]string{"", "одна", "дві"},
+	masculine: [3]string{"", "один", "два"},
+	scales: []slavicScale{
+		{[3]string{"тисяча", "тисячі", "тисяч"}, true},
+		{[3]string{"мільйон", "мільйони", "мільйонів"}, false},
+		{[3]string{"мільярд", "мільярди", "мільярдів"}, false},
+		{[3]string{"трильйон", "трильйони", "трильйонів"}, false},
+	},
+}
+
+var czech = slavic{
+	ones: []string{"nula", "jedna", "dva", "tři", "čtyři", "pět", "šest", "sedm", "osm", "devět", "deset", "jedenáct",
+		"dvanáct", "třináct", "čtrnáct", "patnáct", "šestnáct", "sedmnáct", "osmnáct", "devatenáct"},
+	tens: []string{"", "", "dvacet", "třicet", "čtyřicet", "padesát", "šedesát", "sedmdesát", "osmdesát", "devadesát"},
+	hundreds: []string{"", "sto", "dvě stě", "tři sta", "čtyři sta", "pět set", "šest set", "sedm set", "osm set",
+		"devět set"},
+	feminine:  [3]string{"", "jedna", "dvě"},
+	masculine: [3]string{"", "jeden", "dva"},
+	scales: []slavicScale{
+		{[3]string{"tisíc", "tisíce", "tisíc"}, false},
+		{[3]string{"milion", "miliony", "milionů"}, false},
+		{[3]string{"miliarda", "miliardy", "miliard"}, true},
+		{[3]string{"bilion", "biliony", "bilionů"}, false},
+	},
+	omitOne: true,
+}
+
+var polish = slavic{
+	ones: []string{"zero", "jeden", "dwa", "trzy", "cztery", "pięć", "sześć", "siedem", "osiem", "dziewięć", "dziesięć",
+		"jedenaście", "dwanaście", "trzynaście", "czternaście", "piętnaście", "szesnaście", "siedemnaście",
+		"osiemnaście", "dziewiętnaście"},
+	tens: []string{"", "", "dwadzieścia", "trzydzieści", "czterdzieści", "pięćdziesiąt", "sześćdziesiąt",
+		"siedemdziesiąt", "osiemdziesiąt", "dziewięćdziesiąt"},
+	hundreds: []string{"", "sto", "dwieście", "trzysta", "czterysta", "pięćset", "sześćset", "siedemset", "osiemset",
+		"dziewięćset"},
+	feminine:  [3]string{"", "jedna", "dwie"},
+	masculine: [3]string{"", "jeden", "dwa"},
+	scales: []slavicScale{
+		{[3]string{"tysiąc", "tysiące", "tysięcy"}, false},
+		{[3]string{"milion", "miliony", "milionów"}, false},
+		{[3]string{"miliard", "miliardy", "miliardów"}, false},
+		{[3]string{"bilion", "biliony", "bilionów"}, false},
+	},
+	omitOne:     true,
+	singularOne: true,
+}
+
+func (l slavic) cardinal(n uint64) string {
+	if n == 0 {
+		return l.ones[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 1; i-- {
+		scale := l.scales[i-1]
+		switch {
+		case g[i] == 0:
+		case g[i] == 1 && l.omitOne:
+			words = append(words, scale.forms[singular])
+		default:
+			form := pluralForm(g[i])
+			if form == singular && l.singularOne {
+				form = plural
+			}
+			words = append(words, l.count(g[i], scale.feminine), scale.forms[form])
+		}
+	}
+	if g[0] > 0 {
+		words = append(words, l.below1000(g[0], l.ones[1], l.ones[2]))
+	}
+	return strings.Join(words, " ")
+}
+
+// count reads n, which counts a feminine or masculine scale, like "двадцать одна" of "двадцать одна тысяча".
+func (l slavic) count(n int, feminine bool) string {
+	if feminine {
+		return l.below1000(n, l.feminine[1], l.feminine[2])
+	}
+	return l.below1000(n, l.masculine[1], l.masculine[2])
+}
+
+func (l slavic) below1000(n int, one, two string) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, l.hundreds[n/100])
+	}
+	n %= 100
+	if n >= 20 {
+		words = append(words, l.tens[n/10])
+		n %= 10
+	}
+	switch n {
+	case 0:
+	case 1:
+		words = append(words, one)
+	case 2:
+		words = append(words, two)
+	default:
+		words = append(words, l.ones[n])
+	}
+	return strings.Join(words, " ")
+}
+
+// pluralForm returns the form of a noun counted by n: singular for 1, 21, 31, paucal for 2 to 4, 22 to 24, and plural
+// for all others, like 5 and 11 to 14.
+func pluralForm(n int) int {
+	switch {
+	case n%100 >= 11 && n%100 <= 14:
+		return plural
+	case n%10 == 1:
+		return singular
+	case n%10 >= 2 && n%10 <= 4:
+		return paucal
+	}
+	return plural
+}
+
+var (
+	slOnes = []string{"nič", "ena", "dve", "tri", "štiri", "pet", "šest", "sedem", "osem", "devet", "deset", "enajst",
+		"dvanajst", "trinajst", "štirinajst", "petnajst", "šestnajst", "sedemnajst", "osemnajst", "devetnajst"}
+	slTens = []string{"", "", "dvajset", "trideset", "štirideset", "petdeset", "šestdeset", "sedemdeset", "osemdeset",
+		"devetdeset"}
+	// slMasculine are the masculine one to four, which count masculine scales, like "trije milijoni".
+	slMasculine = []string{"", "en", "dva", "trije", "štirje"}
+	// slScales are the singular, dual, plural and genitive plural of the scales from a million on.
+	slScales = []struct {
+		forms    [4]string
+		feminine bool
+	}{
+		{},
+		{},
+		{[4]string{"milijon", "milijona", "milijoni", "milijonov"}, false},
+		{[4]string{"milijarda", "milijardi", "milijarde", "milijard"}, true},
+		{[4]string{"bilijon", "bilijona", "bilijoni", "bilijonov"}, false},
+	}
+)
+
+// slovenian reads numbers in Slovenian, which has a dual and reads the units before the tens, like
+// "dva milijona" and "triindvajset".
+func slovenian(n uint64) string {
+	if n == 0 {
+		return slOnes[0]
+	}
+	g := groups(n)
+	var words []string
+	for i := len(g) - 1; i >= 2; i-- {
+		scale := slScales[i]
+		switch {
+		case g[i] == 0:
+		case g[i] == 1:
+			words = append(words, scale.forms[0])
+		default:
+			form := 3
+			if last := g[i] % 100; last >= 1 && last <= 4 {
+				form = last - 1
+				if form > 2 {
+					form = 2
+				}
+			}
+			words = append(words, slHundreds(g[i], !scale.feminine), scale.forms[form])
+		}
+	}
+	switch g[1] {
+	case 0:
+	case 1:
+		words = append(words, "tisoč")
+	default:
+		// Tisoč is invariable, but counted with the masculine two, like "dva tisoč".
+		count := slHundreds(g[1], false)
+		if g[1]%100 == 2 {
+			count = strings.TrimSuffix(count, "dve") + "dva"
+		}
+		words = append(words, count, "tisoč")
+	}
+	if g[0] > 0 {
+		words = append(words, slHundreds(g[0], false))
+	}
+	return strings.Join(words, " ")
+}
+
+// slHundreds reads n below a thousand. Counts of masculine scales end in the masculine one to four.
+func slHundreds(n int, masculine bool) string {
+	var words []string
+	switch h := n / 100; h {
+	case 0:
+	case 1:
+		words = append(words, "sto")
+	case 2:
+		words = append(words, "dvesto")
+	default:
+		words = append(words, slOnes[h]+"sto")
+	}
+	switch n %= 100; {
+	case n >= 1 && n <= 4 && masculine:
+		words = append(words, slMasculine[n])
+	case n >= 20 && n%10 == 2:
+		words = append(words, "dvain"+slTens[n/10])
+	case n >= 20 && n%10 > 0:
+		words = append(words, slOnes[n%10]+"in"+slTens[n/10])
+	case n >= 20:
+		words = append(words, slTens[n/10])
+	case n > 0:
+		words = append(words, slOnes[n])
+	}
+	return strings.Join(words, " ")
+}
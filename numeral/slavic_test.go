@@ -0,0 +1,70 @@
+package numeral
+
+import (
+	"testing"
+)
+
+func TestRussian(t *testing.T) {
+	testCardinal(t, "ru", map[uint64]string{
+		1:        "один",
+		2:        "два",
+		1000:     "одна тысяча",
+		2000:     "две тысячи",
+		5000:     "пять тысяч",
+		11000:    "одиннадцать тысяч",
+		21000:    "двадцать одна тысяча",
+		22000:    "двадцать две тысячи",
+		2000000:  "два миллиона",
+		21000000: "двадцать один миллион",
+	})
+}
+
+func TestUkrainian(t *testing.T) {
+	testCardinal(t, "uk", map[uint64]string{
+		2:       "два",
+		2000:    "дві тисячі",
+		5000:    "п'ять тисяч",
+		21000:   "двадцять одна тисяча",
+		2000000: "два мільйони",
+	})
+}
+
+func TestCzech(t *testing.T) {
+	testCardinal(t, "cs", map[uint64]string{
+		1:          "jedna",
+		200:        "dvě stě",
+		1000:       "tisíc",
+		2000:       "dva tisíce",
+		5000:       "pět tisíc",
+		2000000000: "dvě miliardy",
+	})
+}
+
+func TestPolish(t *testing.T) {
+	testCardinal(t, "pl", map[uint64]string{
+		1:          "jeden",
+		200:        "dwieście",
+		1000:       "tysiąc",
+		2000:       "dwa tysiące",
+		5000:       "pięć tysięcy",
+		12000:      "dwanaście tysięcy",
+		21000:      "dwadzieścia jeden tysięcy",
+		22000:      "dwadzieścia dwa tysiące",
+		1000000:    "milion",
+		3000000:    "trzy miliony",
+		5000000:    "pięć milionów",
+		2000000000: "dwa miliardy",
+	})
+}
+
+func TestSlovenian(t *testing.T) {
+	testCardinal(t, "sl", map[uint64]string{
+		22:         "dvaindvajset",
+		123:        "sto triindvajset",
+		2000:       "dva tisoč",
+		2000000:    "dva milijona",
+		3000000:    "trije milijoni",
+		5000000:    "pet milijonov",
+		2000000000: "dve milijardi",
+	})
+}
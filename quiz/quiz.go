@@ -0,0 +1,222 @@
+// Package quiz trains spelling alphabets in timed rounds with spaced repetition. Clients should not use this internal package, used by github.com/simonnagl/spell/cmd/spell.
+package quiz
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/simonnagl/spell/alphabet"
+	"io"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Drill is a type of question.
+type Drill int
+
+const (
+	CharToWord Drill = iota // ask for the phonetic form of a character
+	WordToChar              // ask for the character of a phonetic form
+	Mixed                   // ask both types of questions
+)
+
+// Quiz asks questions about the letters and digits of a spelling alphabet.
+type Quiz struct {
+	Alphabet alphabet.SpellingAlphabet
+	Drill    Drill
+	// Rounds is the number of rounds.
+	Rounds int
+	// Questions is the maximal number of questions in each round.
+	Questions int
+	// TimeLimit of each round. Zero disables the limit.
+	TimeLimit time.Duration
+	// State stores the progress of all answers.
+	State *State
+	// Now returns the current time.
+	Now func() time.Time
+	// Rand chooses the questions.
+	Rand *rand.Rand
+}
+
+// Result counts the answers of a Quiz.
+type Result struct {
+	Asked   int
+	Correct int
+}
+
+// question is a question of a Quiz.
+type question struct {
+	key   string
+	word  string
+	drill Drill
+}
+
+// Run asks questions on out and reads the answers line by line from in.
+// It prints a summary after each round and statistics for each asked key at the end.
+// Run ends after all rounds or if in has no more answers.
+func (q Quiz) Run(in io.Reader, out io.Writer) Result {
+	answers := readLines(in)
+	keys := q.keys()
+	lang := q.Alphabet.LangTag()
+
+	var result Result
+	asked := make(map[string]bool)
+	var last string
+	done := false
+	for round := 1; round <= q.Rounds && !done; round++ {
+		var timeout <-chan time.Time
+		if q.TimeLimit > 0 {
+			timeout = time.After(q.TimeLimit)
+		}
+		start := q.Now()
+
+		var roundResult Result
+		for i := 0; i < q.Questions; i++ {
+			key := q.State.next(lang, keys, last, q.Now(), q.Rand)
+			last = key
+			qu := q.question(key)
+			fmt.Fprint(out, q.prompt(qu))
+
+			var answer string
+			var ok bool
+			select {
+			case answer, ok = <-answers:
+				if !ok {
+					done = true
+					fmt.Fprintln(out)
+				}
+			case <-timeout:
+				fmt.Fprintln(out, "\nTime is up.")
+			}
+			if !ok {
+				break
+			}
+
+			correct, feedback := q.check(qu, answer)
+			fmt.Fprintln(out, feedback)
+			q.State.Card(lang, key).Record(correct, q.Now())
+			asked[key] = true
+			roundResult.Asked++
+			if correct {
+				roundResult.Correct++
+			}
+		}
+
+		fmt.Fprintf(out, "Round %d: %d of %d correct in %v\n\n",
+			round, roundResult.Correct, roundResult.Asked, q.Now().Sub(start).Round(time.Second))
+		result.Asked += roundResult.Asked
+		result.Correct += roundResult.Correct
+	}
+
+	q.printStatistics(out, asked)
+	return result
+}
+
+// keys returns the keys of all letters and digits of the alphabet.
+func (q Quiz) keys() []string {
+	var keys []string
+	for _, key := range q.Alphabet.Keys() {
+		if alphabet.KindOf(key) != alphabet.Symbol {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (q Quiz) question(key string) question {
+	drill := q.Drill
+	if drill == Mixed {
+		drill = Drill(q.Rand.Intn(2))
+	}
+	return question{key, q.Alphabet.Spell(key), drill}
+}
+
+// char returns the character of key in upper case.
+func (q Quiz) char(key string) string {
+	char, _ := q.Alphabet.Decode(q.Alphabet.Spell(key))
+	return char
+}
+
+func (q Quiz) prompt(qu question) string {
+	if qu.drill == CharToWord {
+		return fmt.Sprintf("Spell '%s': ", q.char(qu.key))
+	}
+	return fmt.Sprintf("Which character is '%s'? ", qu.word)
+}
+
+// check reports whether answer is correct and returns feedback for the user.
+//
+// A phonetic form is tolerated, if the correct key is its best fuzzy match and no other key ranks as high.
+// Characters are compared case insensitive.
+func (q Quiz) check(qu question, answer string) (bool, string) {
+	want := q.char(qu.key)
+	answer = strings.TrimSpace(answer)
+
+	if qu.drill == WordToChar {
+		got, _ := q.Alphabet.Decode(q.Alphabet.Spell(answer))
+		if got == want {
+			return true, "Correct."
+		}
+		return false, fmt.Sprintf("Wrong: '%s' is %s.", qu.word, want)
+	}
+
+	if answer == qu.word {
+		return true, "Correct."
+	}
+	if matches := q.Alphabet.DecodeFuzzy(answer); len(matches) == 1 && ranksFirst(matches[0], want) {
+		return true, fmt.Sprintf("Correct: %s.", qu.word)
+	}
+	return false, fmt.Sprintf("Wrong: %s is %s.", want, qu.word)
+}
+
+// ranksFirst reports whether key is the best Candidate of m and no alternative is as confident as it.
+func ranksFirst(m alphabet.FuzzyMatch, key string) bool {
+	if m.Key != key {
+		return false
+	}
+	for _, alt := range m.Alternatives {
+		if alt.Confidence == m.Confidence {
+			return false
+		}
+	}
+	return true
+}
+
+func (q Quiz) printStatistics(out io.Writer, asked map[string]bool) {
+	if len(asked) == 0 {
+		return
+	}
+	lang := q.Alphabet.LangTag()
+
+	keys := make([]string, 0, len(asked))
+	for key := range asked {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ci, cj := q.State.Card(lang, keys[i]), q.State.Card(lang, keys[j])
+		if ci.Wrong != cj.Wrong {
+			return ci.Wrong > cj.Wrong
+		}
+		return keys[i] < keys[j]
+	})
+
+	fmt.Fprintln(out, "Statistics:")
+	for _, key := range keys {
+		c := q.State.Card(lang, key)
+		fmt.Fprintf(out, "  %-4s%-20s%d of %d correct\n", q.char(key), q.Alphabet.Spell(key), c.Correct, c.Correct+c.Wrong)
+	}
+}
+
+// readLines sends each line of in to the returned channel, which is closed at the end of in.
+func readLines(in io.Reader) <-chan string {
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+	return lines
+}
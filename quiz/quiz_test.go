@@ -0,0 +1,119 @@
+package quiz
+
+import (
+	"bytes"
+	"github.com/simonnagl/spell/alphabet"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testQuiz(drill Drill) Quiz {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	return Quiz{
+		Alphabet:  alphabet.German,
+		Drill:     drill,
+		Rounds:    2,
+		Questions: 3,
+		State:     &State{Alphabets: make(map[string]map[string]*Card)},
+		Now:       func() time.Time { return now },
+		Rand:      rand.New(rand.NewSource(1)),
+	}
+}
+
+func TestQuiz_Check(t *testing.T) {
+	q := testQuiz(Mixed)
+	tests := []struct {
+		qu          question
+		answer      string
+		wantCorrect bool
+	}{
+		{question{"b", "Berta", CharToWord}, "Berta", true},
+		{question{"b", "Berta", CharToWord}, " berta ", true},
+		{question{"b", "Berta", CharToWord}, "Bertha", true},
+		{question{"b", "Berta", CharToWord}, "Anton", false},
+		{question{"b", "Berta", CharToWord}, "", false},
+		{question{"sch", "Schule", CharToWord}, "schule", true},
+		{question{"ä", "Ärger", WordToChar}, "Ä", true},
+		{question{"ä", "Ärger", WordToChar}, "ä", true},
+		{question{"ä", "Ärger", WordToChar}, "a", false},
+		{question{"sch", "Schule", WordToChar}, "Sch", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.qu.word+" "+tt.answer, func(t *testing.T) {
+			if correct, feedback := q.check(tt.qu, tt.answer); correct != tt.wantCorrect {
+				t.Errorf("check() = %v %q, want %v", correct, feedback, tt.wantCorrect)
+			}
+		})
+	}
+}
+
+func TestQuiz_CheckVariants(t *testing.T) {
+	q := testQuiz(CharToWord)
+	q.Alphabet = alphabet.English
+	tests := []struct {
+		qu          question
+		answer      string
+		wantCorrect bool
+	}{
+		{question{"a", "Alfa", CharToWord}, "Alpha", true},
+		{question{"j", "Juliett", CharToWord}, "Juliet", true},
+		{question{"9", "Nine", CharToWord}, "Niner", true},
+		{question{"5", "Five", CharToWord}, "Fife", true},
+		{question{"5", "Five", CharToWord}, "fine", false},
+		{question{"9", "Nine", CharToWord}, "fine", false},
+		{question{"a", "Alfa", CharToWord}, "Alfa Bravo", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.qu.word+" "+tt.answer, func(t *testing.T) {
+			if correct, feedback := q.check(tt.qu, tt.answer); correct != tt.wantCorrect {
+				t.Errorf("check() = %v %q, want %v", correct, feedback, tt.wantCorrect)
+			}
+		})
+	}
+}
+
+func TestQuiz_Run(t *testing.T) {
+	q := testQuiz(WordToChar)
+	var out bytes.Buffer
+	result := q.Run(strings.NewReader("a\nb\nc\nd\ne\nf\ng\n"), &out)
+
+	if result.Asked != 6 {
+		t.Errorf("Run() asked %d questions, want 6 questions in 2 rounds", result.Asked)
+	}
+	for _, want := range []string{"Which character is", "Round 1: ", "Round 2: ", "Statistics:"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Run() output should contain %q, but was:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestQuiz_RunEndOfInput(t *testing.T) {
+	q := testQuiz(CharToWord)
+	var out bytes.Buffer
+	result := q.Run(strings.NewReader("Anton\n"), &out)
+
+	if result.Asked != 1 {
+		t.Errorf("Run() asked %d questions, want 1 answered question", result.Asked)
+	}
+	if strings.Contains(out.String(), "Round 2") {
+		t.Errorf("Run() should stop at the end of input, but was:\n%s", out.String())
+	}
+}
+
+func TestQuiz_RunTimeLimit(t *testing.T) {
+	q := testQuiz(CharToWord)
+	q.Rounds = 1
+	q.TimeLimit = 10 * time.Millisecond
+
+	r, w := io.Pipe()
+	defer w.Close()
+	var out bytes.Buffer
+	result := q.Run(r, &out)
+
+	if result.Asked != 0 || !strings.Contains(out.String(), "Time is up.") {
+		t.Errorf("Run() = %+v should run out of time, output was:\n%s", result, out.String())
+	}
+}
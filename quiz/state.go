@@ -0,0 +1,148 @@
+package quiz
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// intervals between two questions of a Card for each Leitner box.
+//
+// A correct answer moves a Card into the next box, so it is asked less often.
+// A wrong answer moves it back into the first box, so it is asked again soon.
+var intervals = []time.Duration{
+	0,
+	10 * time.Minute,
+	time.Hour,
+	24 * time.Hour,
+	3 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+// Card is the learning progress of one key of a spelling alphabet.
+type Card struct {
+	// Box is the Leitner box of the Card. Cards in higher boxes are asked less often.
+	Box int `json:"box"`
+	// Due is the time the Card should be asked again.
+	Due time.Time `json:"due"`
+	// Correct is the number of correct answers.
+	Correct int `json:"correct"`
+	// Wrong is the number of wrong answers.
+	Wrong int `json:"wrong"`
+}
+
+// Record updates the Card with an answer given at now.
+func (c *Card) Record(correct bool, now time.Time) {
+	if correct {
+		c.Correct++
+		if c.Box < len(intervals)-1 {
+			c.Box++
+		}
+	} else {
+		c.Wrong++
+		c.Box = 0
+	}
+	c.Due = now.Add(intervals[c.Box])
+}
+
+// State is the learning progress of all spelling alphabets.
+type State struct {
+	// Alphabets maps the language tag of a spelling alphabet to the Cards of its keys.
+	Alphabets map[string]map[string]*Card `json:"alphabets"`
+}
+
+// Load reads a State from the file path. A missing file returns an empty State.
+func Load(path string) (*State, error) {
+	s := &State{Alphabets: make(map[string]map[string]*Card)}
+
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(content, s); err != nil {
+		return nil, err
+	}
+	if s.Alphabets == nil {
+		s.Alphabets = make(map[string]map[string]*Card)
+	}
+	return s, nil
+}
+
+// Save writes s to the file path. It creates missing directories.
+func (s *State) Save(path string) error {
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0644)
+}
+
+// Card returns the Card for key of the spelling alphabet with language tag lang. It creates missing Cards.
+func (s *State) Card(lang string, key string) *Card {
+	cards, ok := s.Alphabets[lang]
+	if !ok {
+		cards = make(map[string]*Card)
+		s.Alphabets[lang] = cards
+	}
+	card, ok := cards[key]
+	if !ok {
+		card = &Card{}
+		cards[key] = card
+	}
+	return card
+}
+
+// peek returns the Card for key of the spelling alphabet with language tag lang or a new Card, if there is none.
+// Other than Card, it does not add new Cards to s.
+func (s *State) peek(lang string, key string) Card {
+	if card, ok := s.Alphabets[lang][key]; ok {
+		return *card
+	}
+	return Card{}
+}
+
+// next chooses the key to ask next out of keys.
+//
+// Keys, which are due at now, are preferred. Cards in lower boxes are chosen more likely.
+// The key last asked is only chosen again, if it is the only one.
+func (s *State) next(lang string, keys []string, last string, now time.Time, rnd *rand.Rand) string {
+	var candidates []string
+	for _, key := range keys {
+		if key != last && !s.peek(lang, key).Due.After(now) {
+			candidates = append(candidates, key)
+		}
+	}
+	if len(candidates) == 0 {
+		for _, key := range keys {
+			if key != last || len(keys) == 1 {
+				candidates = append(candidates, key)
+			}
+		}
+	}
+
+	// Each box halves the chance to be chosen.
+	weights := make([]int, len(candidates))
+	var total int
+	for i, key := range candidates {
+		weights[i] = 1 << uint(len(intervals)-1-s.peek(lang, key).Box)
+		total += weights[i]
+	}
+	r := rnd.Intn(total)
+	for i, w := range weights {
+		if r < w {
+			return candidates[i]
+		}
+		r -= w
+	}
+	return candidates[len(candidates)-1]
+}
@@ -0,0 +1,89 @@
+package quiz
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCard_Record(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	c := &Card{}
+
+	c.Record(true, now)
+	c.Record(true, now)
+	if c.Box != 2 || c.Correct != 2 || !c.Due.Equal(now.Add(intervals[2])) {
+		t.Errorf("Two correct answers should move a card into box 2, but card is %+v", c)
+	}
+
+	c.Record(false, now)
+	if c.Box != 0 || c.Wrong != 1 || !c.Due.Equal(now) {
+		t.Errorf("A wrong answer should move a card back into box 0, but card is %+v", c)
+	}
+
+	for i := 0; i < 2*len(intervals); i++ {
+		c.Record(true, now)
+	}
+	if c.Box != len(intervals)-1 {
+		t.Errorf("Card should stay in the last box, but card is %+v", c)
+	}
+}
+
+func TestState_SaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quiz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "spell", "quiz.json")
+
+	s, err := Load(path)
+	if err != nil || len(s.Alphabets) != 0 {
+		t.Fatalf("Load() of a missing file = %v, %v, want an empty state", s, err)
+	}
+
+	s.Card("de-DE", "a").Record(false, time.Now())
+	if err := s.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := loaded.Card("de-DE", "a"); c.Wrong != 1 {
+		t.Errorf("Loaded card = %+v, want one wrong answer", c)
+	}
+}
+
+func TestState_Next(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	keys := []string{"a", "b", "c"}
+	rnd := rand.New(rand.NewSource(1))
+
+	s := &State{Alphabets: make(map[string]map[string]*Card)}
+	s.Card("en", "a").Record(true, now)
+	s.Card("en", "b").Record(true, now)
+	for i := 0; i < 10; i++ {
+		if key := s.next("en", keys, "", now, rnd); key != "c" {
+			t.Errorf("next() = %v, want the only due key c", key)
+		}
+	}
+	if key := s.next("en", keys, "c", now, rnd); key == "c" {
+		t.Errorf("next() = %v, should not repeat the last key", key)
+	}
+
+	s.Card("en", "c").Record(false, now)
+	s.Card("en", "c").Record(false, now)
+	counts := make(map[string]int)
+	later := now.Add(24 * time.Hour)
+	for i := 0; i < 1000; i++ {
+		counts[s.next("en", keys, "", later, rnd)]++
+	}
+	if counts["c"] <= counts["a"] || counts["c"] <= counts["b"] {
+		t.Errorf("next() chose %v, but missed key c should come back more often", counts)
+	}
+}